@@ -0,0 +1,230 @@
+package slice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Backoff decides whether a failed batch is retried and how long to wait first.
+// attempt is the number of attempts made so far, starting at 1, and err is the error of the last attempt.
+type Backoff func(attempt int, err error) (time.Duration, bool)
+
+// NoRetry is a Backoff that never retries.
+func NoRetry(int, error) (time.Duration, bool) {
+	return 0, false
+}
+
+// ConstantBackoff retries up to retries times, waiting d before each retry.
+//
+// Parameters:
+//   - d: The delay before each retry.
+//   - retries: The maximum number of retries.
+//
+// Returns:
+//
+//   - Backoff: The backoff policy.
+func ConstantBackoff(d time.Duration, retries int) Backoff {
+	return func(attempt int, _ error) (time.Duration, bool) {
+		return d, attempt <= retries
+	}
+}
+
+// ExponentialBackoff retries up to retries times, doubling the delay from base after each attempt, up to limit.
+//
+// Parameters:
+//   - base: The delay before the first retry.
+//   - limit: The longest delay, or 0 for no limit.
+//   - retries: The maximum number of retries.
+//
+// Returns:
+//
+//   - Backoff: The backoff policy.
+func ExponentialBackoff(base, limit time.Duration, retries int) Backoff {
+	return func(attempt int, _ error) (time.Duration, bool) {
+		if attempt > retries {
+			return 0, false
+		}
+		d := base
+		for i := 1; i < attempt && (limit <= 0 || d < limit); i++ {
+			d *= 2
+		}
+		if limit > 0 && d > limit {
+			d = limit
+		}
+		return d, true
+	}
+}
+
+// BatchOption configures ProcessBatches.
+type BatchOption func(*batchOptions)
+
+// batchOptions holds the settings collected from BatchOption values.
+type batchOptions struct {
+	concurrency int
+	backoff     Backoff
+}
+
+// WithConcurrency runs up to n batches at the same time. The default, 1, runs batches sequentially in order.
+//
+// Parameters:
+//   - n: The maximum number of batches in flight.
+//
+// Returns:
+//
+//   - BatchOption: The option.
+func WithConcurrency(n int) BatchOption {
+	return func(o *batchOptions) {
+		o.concurrency = n
+	}
+}
+
+// WithBackoff sets the retry policy for failed batches. The default is NoRetry.
+//
+// Parameters:
+//   - b: The backoff policy.
+//
+// Returns:
+//
+//   - BatchOption: The option.
+func WithBackoff(b Backoff) BatchOption {
+	return func(o *batchOptions) {
+		o.backoff = b
+	}
+}
+
+// BatchResult describes the outcome of one batch.
+type BatchResult struct {
+	// Index is the position of the batch, starting at 0.
+	Index int
+	// Start and End are the bounds of the batch in the input, as in s[Start:End].
+	Start, End int
+	// Attempts is the number of times the batch function was called; 0 if the batch never ran.
+	Attempts int
+	// Err is the error of the last attempt, or nil if the batch succeeded.
+	Err error
+}
+
+// BatchReport lists the batches processed by ProcessBatches, each in index order.
+type BatchReport struct {
+	Succeeded []BatchResult
+	Failed    []BatchResult
+}
+
+// Err returns an error joining the errors of every failed batch, or nil if all batches succeeded.
+//
+// Returns:
+//
+//   - error: The joined error.
+func (r *BatchReport) Err() error {
+	errs := Map(r.Failed, func(b BatchResult, _ int) error {
+		return fmt.Errorf("batch %d [%d:%d]: %w", b.Index, b.Start, b.End, b.Err)
+	})
+	return errors.Join(errs...)
+}
+
+// ProcessBatches splits the slice into batches of at most size elements and calls fn on each.
+// Failed batches are retried according to the backoff policy. If ctx is cancelled, batches that
+// have not started are reported as failed with the context error and no further retries are made.
+//
+// Parameters:
+//   - ctx: The context passed to fn; cancelling it stops processing.
+//   - s: The slice to process.
+//   - size: The maximum number of elements in a batch.
+//   - fn: The function called with each batch. It must not retain the batch after returning.
+//   - opts: Options for concurrency and retries.
+//
+// Returns:
+//
+//   - *BatchReport: The outcome of every batch.
+//   - error: An error wrapping ErrInvalidBatchSize if size is not positive, otherwise the result of BatchReport.Err.
+//
+// Example:
+//
+//	report, err := ProcessBatches(ctx, rows, 100, insert,
+//		WithConcurrency(4),
+//		WithBackoff(ExponentialBackoff(100*time.Millisecond, 5*time.Second, 3)),
+//	)
+func ProcessBatches[T any](ctx context.Context, s IAdvancedSlice[T], size int, fn func(ctx context.Context, batch []T) error, opts ...BatchOption) (*BatchReport, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidBatchSize, size)
+	}
+	o := batchOptions{concurrency: 1, backoff: NoRetry}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	o.concurrency = max(o.concurrency, 1)
+
+	data := s.Values()
+	results := make([]BatchResult, (len(data)+size-1)/size)
+	for i := range results {
+		start := i * size
+		results[i] = BatchResult{Index: i, Start: start, End: min(start+size, len(data))}
+	}
+
+	jobs := make(chan *BatchResult)
+	var wg sync.WaitGroup
+	for range min(o.concurrency, len(results)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range jobs {
+				runBatch(ctx, r, data[r.Start:r.End:r.End], fn, o.backoff)
+			}
+		}()
+	}
+	next := 0
+feed:
+	for ; next < len(results); next++ {
+		select {
+		case jobs <- &results[next]:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	for i := next; i < len(results); i++ {
+		results[i].Err = ctx.Err()
+	}
+
+	report := &BatchReport{}
+	for _, r := range results {
+		if r.Err == nil {
+			report.Succeeded = append(report.Succeeded, r)
+		} else {
+			report.Failed = append(report.Failed, r)
+		}
+	}
+	return report, report.Err()
+}
+
+// runBatch calls fn on one batch, retrying per backoff, and records the outcome in r.
+func runBatch[T any](ctx context.Context, r *BatchResult, batch []T, fn func(context.Context, []T) error, backoff Backoff) {
+	for {
+		if err := ctx.Err(); err != nil {
+			if r.Attempts == 0 {
+				r.Err = err
+			}
+			return
+		}
+		r.Attempts++
+		r.Err = fn(ctx, batch)
+		if r.Err == nil {
+			return
+		}
+		d, ok := backoff(r.Attempts, r.Err)
+		if !ok {
+			return
+		}
+		timer := time.NewTimer(d)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+	}
+}
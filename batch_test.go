@@ -0,0 +1,145 @@
+package slice_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestProcessBatches(t *testing.T) {
+	s := slice.NewAdvancedSlice(1, 2, 3, 4, 5, 6, 7)
+	var got [][]int
+	report, err := slice.ProcessBatches(context.Background(), s, 3, func(_ context.Context, batch []int) error {
+		got = append(got, append([]int(nil), batch...))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ProcessBatches() error = %v", err)
+	}
+	if want := [][]int{{1, 2, 3}, {4, 5, 6}, {7}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("batches = %v, want %v", got, want)
+	}
+	want := []slice.BatchResult{
+		{Index: 0, Start: 0, End: 3, Attempts: 1},
+		{Index: 1, Start: 3, End: 6, Attempts: 1},
+		{Index: 2, Start: 6, End: 7, Attempts: 1},
+	}
+	if !reflect.DeepEqual(report.Succeeded, want) || len(report.Failed) != 0 {
+		t.Errorf("report = %+v, want %+v", report, want)
+	}
+
+	if _, err := slice.ProcessBatches(context.Background(), s, 0, nil); !errors.Is(err, slice.ErrInvalidBatchSize) {
+		t.Errorf("ProcessBatches(size 0) error = %v", err)
+	}
+	report, err = slice.ProcessBatches(context.Background(), slice.NewAdvancedSlice[int](), 3, nil)
+	if err != nil || len(report.Succeeded)+len(report.Failed) != 0 {
+		t.Errorf("ProcessBatches(empty) = %+v, %v", report, err)
+	}
+}
+
+func TestProcessBatchesRetry(t *testing.T) {
+	errSink := errors.New("sink unavailable")
+	s := slice.NewAdvancedSlice(1, 2, 3, 4)
+	var mu sync.Mutex
+	calls := map[int]int{}
+	fn := func(_ context.Context, batch []int) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[batch[0]]++
+		switch {
+		case batch[0] == 3:
+			return errSink // always fails
+		case calls[batch[0]] < 3:
+			return errSink // succeeds on the third attempt
+		}
+		return nil
+	}
+	report, err := slice.ProcessBatches(context.Background(), s, 2, fn,
+		slice.WithBackoff(slice.ConstantBackoff(time.Millisecond, 2)),
+		slice.WithConcurrency(2),
+	)
+	if !errors.Is(err, errSink) {
+		t.Errorf("ProcessBatches() error = %v, want %v", err, errSink)
+	}
+	if len(report.Succeeded) != 1 || report.Succeeded[0].Index != 0 || report.Succeeded[0].Attempts != 3 {
+		t.Errorf("Succeeded = %+v", report.Succeeded)
+	}
+	if len(report.Failed) != 1 || report.Failed[0].Index != 1 || report.Failed[0].Attempts != 3 || !errors.Is(report.Failed[0].Err, errSink) {
+		t.Errorf("Failed = %+v", report.Failed)
+	}
+
+	report, _ = slice.ProcessBatches(context.Background(), s, 2, func(context.Context, []int) error { return errSink })
+	for _, r := range report.Failed {
+		if r.Attempts != 1 {
+			t.Errorf("ProcessBatches() without backoff made %d attempts", r.Attempts)
+		}
+	}
+}
+
+func TestProcessBatchesConcurrency(t *testing.T) {
+	s := slice.NewAdvancedSlice(make([]int, 40)...)
+	var inFlight, peak atomic.Int32
+	report, err := slice.ProcessBatches(context.Background(), s, 2, func(context.Context, []int) error {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+		return nil
+	}, slice.WithConcurrency(3))
+	if err != nil || len(report.Succeeded) != 20 {
+		t.Fatalf("ProcessBatches() = %+v, %v", report, err)
+	}
+	if p := peak.Load(); p > 3 || p < 2 {
+		t.Errorf("peak concurrency = %d, want at most 3", p)
+	}
+	for i, r := range report.Succeeded {
+		if r.Index != i {
+			t.Errorf("Succeeded[%d].Index = %d, want index order", i, r.Index)
+		}
+	}
+}
+
+func TestProcessBatchesCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := slice.NewAdvancedSlice(1, 2, 3, 4, 5)
+	report, err := slice.ProcessBatches(ctx, s, 1, func(_ context.Context, batch []int) error {
+		if batch[0] == 2 {
+			cancel()
+		}
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ProcessBatches() error = %v, want context.Canceled", err)
+	}
+	if len(report.Succeeded) != 2 || len(report.Failed) != 3 {
+		t.Errorf("report = %+v, want 2 succeeded and 3 failed", report)
+	}
+	for _, r := range report.Failed {
+		if r.Attempts != 0 {
+			t.Errorf("batch %d ran after cancellation", r.Index)
+		}
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	b := slice.ExponentialBackoff(10*time.Millisecond, 50*time.Millisecond, 4)
+	for attempt, want := range []time.Duration{10, 20, 40, 50} {
+		if d, ok := b(attempt+1, nil); !ok || d != want*time.Millisecond {
+			t.Errorf("attempt %d = %v, %v, want %v", attempt+1, d, ok, want*time.Millisecond)
+		}
+	}
+	if _, ok := b(5, nil); ok {
+		t.Error("attempt 5 retried, want stop after 4 retries")
+	}
+}
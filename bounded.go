@@ -0,0 +1,240 @@
+package slice
+
+import "math/rand/v2"
+
+// OverflowPolicy decides what a BoundedSlice does when an insertion would exceed its limit.
+type OverflowPolicy int
+
+const (
+	// OverflowEvict makes room by evicting elements from the opposite end:
+	// Push and Concat evict from the front, Unshift evicts from the back.
+	OverflowEvict OverflowPolicy = iota
+	// OverflowReject keeps the existing elements and drops the incoming ones that do not fit.
+	OverflowReject
+)
+
+var _ IAdvancedSlice[any] = (*BoundedSlice[any])(nil)
+
+// BoundedSlice is an advanced slice that never holds more than a fixed number of elements.
+// Every element dropped because of the limit, whether evicted or rejected, is counted
+// and passed to the OnDrop callback if one is set.
+type BoundedSlice[T any] struct {
+	*advancedSlice[T]
+	limit   int
+	policy  OverflowPolicy
+	onDrop  func(T)
+	dropped int
+}
+
+// NewBoundedSlice creates a new bounded slice.
+//
+// Parameters:
+//   - limit: The maximum number of elements. Values below zero are treated as zero.
+//   - policy: What to do on overflow.
+//   - data: The initial elements, inserted as if by Push.
+//
+// Returns:
+//
+//   - *BoundedSlice[T]: The bounded slice.
+//
+// Example:
+//
+//	// Keep the 100 most recent events.
+//	recent := NewBoundedSlice[Event](100, OverflowEvict)
+//	recent.Push(e)
+func NewBoundedSlice[T any](limit int, policy OverflowPolicy, data ...T) *BoundedSlice[T] {
+	s := &BoundedSlice[T]{
+		advancedSlice: &advancedSlice[T]{},
+		limit:         max(limit, 0),
+		policy:        policy,
+	}
+	s.Push(data...)
+	return s
+}
+
+// OnDrop sets a callback invoked for every element evicted or rejected because of the limit.
+//
+// Parameters:
+//   - f: The callback, or nil to remove it.
+//
+// Returns:
+//
+//   - *BoundedSlice[T]: The bounded slice, for chaining.
+func (s *BoundedSlice[T]) OnDrop(f func(T)) *BoundedSlice[T] {
+	s.onDrop = f
+	return s
+}
+
+// Limit returns the maximum number of elements.
+func (s *BoundedSlice[T]) Limit() int {
+	return s.limit
+}
+
+// Dropped returns the number of elements evicted or rejected so far.
+func (s *BoundedSlice[T]) Dropped() int {
+	return s.dropped
+}
+
+// drop records the given elements as dropped.
+func (s *BoundedSlice[T]) drop(values []T) {
+	s.dropped += len(values)
+	if s.onDrop == nil {
+		return
+	}
+	for _, v := range values {
+		s.onDrop(v)
+	}
+}
+
+// Push appends the values, evicting from the front or rejecting the values that do not fit.
+func (s *BoundedSlice[T]) Push(values ...T) IAdvancedSlice[T] {
+	if s.policy == OverflowReject {
+		room := min(s.limit-len(s.data), len(values))
+		s.data = append(s.data, values[:room]...)
+		s.drop(values[room:])
+		return s
+	}
+	s.data = append(s.data, values...)
+	if over := len(s.data) - s.limit; over > 0 {
+		s.drop(s.data[:over])
+		// Shift the survivors down rather than reslicing, so the evicted front of the
+		// backing array does not stay reachable.
+		n := copy(s.data, s.data[over:])
+		clear(s.data[n:])
+		s.data = s.data[:n]
+	}
+	return s
+}
+
+// PushSlice appends the elements of the given slices, applying the overflow policy.
+func (s *BoundedSlice[T]) PushSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	for _, v := range values {
+		s.Push(v.Values()...)
+	}
+	return s
+}
+
+// Concat appends the elements of the given slices, applying the overflow policy.
+func (s *BoundedSlice[T]) Concat(ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	return s.PushSlice(ss...)
+}
+
+// Unshift prepends the values, evicting from the back or rejecting the values that do not fit.
+// When rejecting, the values closest to the existing elements are kept.
+func (s *BoundedSlice[T]) Unshift(values ...T) IAdvancedSlice[T] {
+	if s.policy == OverflowReject {
+		room := min(s.limit-len(s.data), len(values))
+		cut := len(values) - room
+		s.drop(values[:cut])
+		s.data = append(append([]T(nil), values[cut:]...), s.data...)
+		return s
+	}
+	s.data = append(append([]T(nil), values...), s.data...)
+	if over := len(s.data) - s.limit; over > 0 {
+		s.drop(s.data[s.limit:])
+		clear(s.data[s.limit:])
+		s.data = s.data[:s.limit]
+	}
+	return s
+}
+
+// UnshiftSlice prepends the elements of each given slice in turn, applying the overflow policy.
+func (s *BoundedSlice[T]) UnshiftSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	for _, v := range values {
+		s.Unshift(v.Values()...)
+	}
+	return s
+}
+
+// CopyWithIn keeps only the elements at the given indices, trimming from the front if the result exceeds the limit.
+// Out-of-range indices are handled according to the slice's BoundsPolicy.
+func (s *BoundedSlice[T]) CopyWithIn(indexes ...int) IAdvancedSlice[T] {
+	s.advancedSlice.CopyWithIn(indexes...)
+	data := s.data
+	s.data = nil
+	policy := s.policy
+	s.policy = OverflowEvict
+	s.Push(data...)
+	s.policy = policy
+	return s
+}
+
+// Map replaces each element with the result of f.
+func (s *BoundedSlice[T]) Map(f func(T, int) T) IAdvancedSlice[T] {
+	s.advancedSlice.Map(f)
+	return s
+}
+
+// Unique keeps the first occurrence of each key returned by f.
+func (s *BoundedSlice[T]) Unique(f func(T) string) IAdvancedSlice[T] {
+	s.advancedSlice.Unique(f)
+	return s
+}
+
+// Slice keeps only the selected subset.
+func (s *BoundedSlice[T]) Slice(index ...int) IAdvancedSlice[T] {
+	s.advancedSlice.Slice(index...)
+	return s
+}
+
+// Fill sets the selected elements to value.
+func (s *BoundedSlice[T]) Fill(value T, index ...int) IAdvancedSlice[T] {
+	s.advancedSlice.Fill(value, index...)
+	return s
+}
+
+// Sort sorts the elements using f.
+func (s *BoundedSlice[T]) Sort(f func(T, T) bool) IAdvancedSlice[T] {
+	s.advancedSlice.Sort(f)
+	return s
+}
+
+// Reverse reverses the order of the elements.
+func (s *BoundedSlice[T]) Reverse() IAdvancedSlice[T] {
+	s.advancedSlice.Reverse()
+	return s
+}
+
+// Shuffle randomly permutes the elements in place.
+func (s *BoundedSlice[T]) Shuffle(r *rand.Rand) IAdvancedSlice[T] {
+	s.advancedSlice.Shuffle(r)
+	return s
+}
+
+// Remove removes the elements that satisfy f.
+func (s *BoundedSlice[T]) Remove(f func(T, int) bool) IAdvancedSlice[T] {
+	s.advancedSlice.Remove(f)
+	return s
+}
+
+// RemoveAt removes the element at index.
+func (s *BoundedSlice[T]) RemoveAt(index int) IAdvancedSlice[T] {
+	s.advancedSlice.RemoveAt(index)
+	return s
+}
+
+// RemoveAtErr removes the element at index, or returns an error as RemoveAtErr does.
+func (s *BoundedSlice[T]) RemoveAtErr(index int) (IAdvancedSlice[T], error) {
+	_, err := s.advancedSlice.RemoveAtErr(index)
+	return s, err
+}
+
+// SliceErr keeps only the selected subset, or returns an error as SliceErr does.
+func (s *BoundedSlice[T]) SliceErr(index ...int) (IAdvancedSlice[T], error) {
+	_, err := s.advancedSlice.SliceErr(index...)
+	return s, err
+}
+
+// Begin starts a transaction over the slice.
+func (s *BoundedSlice[T]) Begin(validators ...Validator[T]) *Tx[T] {
+	return Begin[T](s, validators...)
+}
+
+// replace swaps in new contents, trimming from the front if they exceed the limit.
+func (s *BoundedSlice[T]) replace(values []T) {
+	s.data = nil
+	policy := s.policy
+	s.policy = OverflowEvict
+	s.Push(values...)
+	s.policy = policy
+}
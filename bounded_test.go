@@ -0,0 +1,121 @@
+package slice_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestBoundedSlice(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      slice.OverflowPolicy
+		op          func(s *slice.BoundedSlice[int])
+		want        []int
+		wantDropped []int
+	}{
+		{
+			name:        "push evicts front",
+			policy:      slice.OverflowEvict,
+			op:          func(s *slice.BoundedSlice[int]) { s.Push(4, 5) },
+			want:        []int{3, 4, 5},
+			wantDropped: []int{1, 2},
+		},
+		{
+			name:        "unshift evicts back",
+			policy:      slice.OverflowEvict,
+			op:          func(s *slice.BoundedSlice[int]) { s.Unshift(-1, 0) },
+			want:        []int{-1, 0, 1},
+			wantDropped: []int{2, 3},
+		},
+		{
+			name:   "concat evicts front",
+			policy: slice.OverflowEvict,
+			op: func(s *slice.BoundedSlice[int]) {
+				s.Concat(slice.NewAdvancedSlice(4), slice.NewAdvancedSlice(5))
+			},
+			want:        []int{3, 4, 5},
+			wantDropped: []int{1, 2},
+		},
+		{
+			name:        "push rejects",
+			policy:      slice.OverflowReject,
+			op:          func(s *slice.BoundedSlice[int]) { s.Pop(); s.Push(4, 5) },
+			want:        []int{1, 2, 4},
+			wantDropped: []int{5},
+		},
+		{
+			name:        "unshift rejects",
+			policy:      slice.OverflowReject,
+			op:          func(s *slice.BoundedSlice[int]) { s.Shift(); s.Unshift(-1, 0) },
+			want:        []int{0, 2, 3},
+			wantDropped: []int{-1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dropped []int
+			s := slice.NewBoundedSlice(3, tt.policy, 1, 2, 3).OnDrop(func(v int) {
+				dropped = append(dropped, v)
+			})
+			tt.op(s)
+			if got := s.Values(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Values() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(dropped, tt.wantDropped) {
+				t.Errorf("dropped = %v, want %v", dropped, tt.wantDropped)
+			}
+			if s.Dropped() != len(tt.wantDropped) {
+				t.Errorf("Dropped() = %d, want %d", s.Dropped(), len(tt.wantDropped))
+			}
+		})
+	}
+}
+
+func TestBoundedSliceInitialOverflow(t *testing.T) {
+	s := slice.NewBoundedSlice(2, slice.OverflowEvict, 1, 2, 3, 4)
+	if got, want := s.Values(), []int{3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if s.Dropped() != 2 || s.Limit() != 2 {
+		t.Errorf("Dropped() = %d, Limit() = %d", s.Dropped(), s.Limit())
+	}
+	s.CopyWithIn(0, 0, 1)
+	if got, want := s.Values(), []int{3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CopyWithIn() = %v, want %v", got, want)
+	}
+}
+
+func TestBoundedSliceCopyWithInBoundsPolicy(t *testing.T) {
+	s := slice.NewBoundedSlice(2, slice.OverflowEvict, 1, 2, 3)
+	s.SetBoundsPolicy(slice.BoundsError)
+	s.CopyWithIn(0, 5, 1, 1)
+	if err := s.Err(); !errors.Is(err, slice.ErrIndexOutOfRange) {
+		t.Errorf("Err() = %v, want ErrIndexOutOfRange", err)
+	}
+	if got, want := s.Values(), []int{3, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CopyWithIn(0, 5, 1, 1) = %v, want %v", got, want)
+	}
+	if s.Dropped() != 2 {
+		t.Errorf("Dropped() = %d, want 2", s.Dropped())
+	}
+}
+
+func TestBoundedSliceEvictionReusesStorage(t *testing.T) {
+	s := slice.NewBoundedSlice(4, slice.OverflowEvict, 1, 2, 3, 4)
+	s.Push(5)
+	base := &s.Values()[:1][0]
+	for i := 6; i < 1000; i++ {
+		s.Push(i)
+	}
+	// Evicting must shift the kept elements to the front of the same backing array,
+	// not reslice past the evicted ones and keep them reachable.
+	if got := &s.Values()[:1][0]; got != base {
+		t.Error("eviction moved the start of the slice off its backing array")
+	}
+	if got, want := s.Values(), []int{996, 997, 998, 999}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+}
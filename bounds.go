@@ -0,0 +1,149 @@
+package slice
+
+import (
+	"fmt"
+)
+
+// BoundsPolicy decides how an advanced slice treats an index that is out of range
+// and a Pop or Shift on an empty slice.
+type BoundsPolicy int
+
+const (
+	// BoundsZero ignores the index: reads return the zero value and removals are no-ops. This is the default.
+	BoundsZero BoundsPolicy = iota
+	// BoundsClamp moves the index to the nearest valid position.
+	BoundsClamp
+	// BoundsPanic panics with an index out of range message.
+	BoundsPanic
+	// BoundsError behaves like BoundsZero but records the error, which can be read with Err.
+	BoundsError
+)
+
+// resolveIndex applies a bounds policy to an index into a sequence of length n. A negative index
+// counts from the end, as in At, before the policy is applied.
+// It returns the index to use and whether there is one; it panics under BoundsPanic.
+func resolveIndex(n, index int, policy BoundsPolicy) (int, bool) {
+	i := index
+	if i < 0 {
+		i += n
+	}
+	if i >= 0 && i < n {
+		return i, true
+	}
+	switch policy {
+	case BoundsClamp:
+		if n == 0 {
+			return 0, false
+		}
+		return min(max(i, 0), n-1), true
+	case BoundsPanic:
+		panic(fmt.Sprintf("slice: index %d out of range [%d:%d]", index, -n, n))
+	default:
+		return 0, false
+	}
+}
+
+// resolveIndexErr applies a bounds policy to an index for the Err variants, which report an index
+// out of range as an error wrapping ErrIndexOutOfRange instead of panicking, even under BoundsPanic.
+func resolveIndexErr(n, index int, policy BoundsPolicy) (int, error) {
+	if policy == BoundsPanic {
+		policy = BoundsError
+	}
+	if i, ok := resolveIndex(n, index, policy); ok {
+		return i, nil
+	}
+	return 0, indexError(index, n)
+}
+
+// checkRange applies a bounds policy to the begin and end indices of a Fill over a sequence of length n.
+// Either may count back from the end and may equal n; Fill itself clamps whatever lies beyond, so only
+// BoundsPanic and BoundsError need this: it panics under BoundsPanic and otherwise returns an error
+// describing the first index out of range.
+func checkRange(n int, indexes []int, policy BoundsPolicy) error {
+	for _, index := range indexes[:min(len(indexes), 2)] {
+		if index == None || (index >= -n && index <= n) {
+			continue
+		}
+		if policy == BoundsPanic {
+			panic(fmt.Sprintf("slice: index %d out of range [%d:%d]", index, -n, n))
+		}
+		return indexError(index, n)
+	}
+	return nil
+}
+
+// resolveIndexes applies a bounds policy to each index, dropping the ones that have no valid position.
+// It returns the resolved indexes and an error describing the first dropped index.
+func resolveIndexes(n int, indexes []int, policy BoundsPolicy) ([]int, error) {
+	var err error
+	resolved := make([]int, 0, len(indexes))
+	for _, index := range indexes {
+		i, ok := resolveIndex(n, index, policy)
+		if !ok {
+			if err == nil {
+				err = indexError(index, n)
+			}
+			continue
+		}
+		resolved = append(resolved, i)
+	}
+	return resolved, err
+}
+
+// AtErr returns the element at the specified index in the slice.
+//
+// Parameters:
+//   - s: The slice.
+//   - index: The index of the element to retrieve. A negative index counts from the end.
+//
+// Returns:
+//
+//	The element at the specified index, and an error wrapping ErrIndexOutOfRange if the index is out of range.
+func AtErr[T any](s []T, index int) (T, error) {
+	i, err := resolveIndexErr(len(s), index, BoundsError)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return s[i], nil
+}
+
+// RemoveAtErr removes an element at the specified index from the slice.
+//
+// Parameters:
+//   - s: The slice to modify.
+//   - index: The index of the element to remove. A negative index counts from the end.
+//
+// Returns:
+//
+//	The updated slice, and an error wrapping ErrIndexOutOfRange if the index is out of range.
+func RemoveAtErr[T any](s []T, index int) ([]T, error) {
+	i, err := resolveIndexErr(len(s), index, BoundsError)
+	if err != nil {
+		return s, err
+	}
+	return RemoveAt(s, i), nil
+}
+
+// SliceErr is like Slice but reports the inputs Slice silently turns into nil.
+//
+// Parameters:
+//   - s: The original slice.
+//   - indexes: The begin, end and step indexes, as for Slice.
+//
+// Returns:
+//
+//	The sliced sub-slice, and an error wrapping ErrInvalidStep if the step is not positive
+//	or ErrIndexOutOfRange if the begin index lies beyond either end of the slice.
+func SliceErr[T any](s []T, indexes ...int) ([]T, error) {
+	if len(indexes) >= 3 && indexes[2] <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidStep, indexes[2])
+	}
+	if len(indexes) > 0 {
+		begin := indexes[0]
+		if begin != None && (begin < -len(s) || begin > len(s)) {
+			return nil, indexError(indexes[0], len(s))
+		}
+	}
+	return Slice(s, indexes...), nil
+}
@@ -0,0 +1,270 @@
+package slice_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestAtErr(t *testing.T) {
+	tests := []struct {
+		name    string
+		index   int
+		want    int
+		wantErr error
+	}{
+		{"valid index", 1, 2, nil},
+		{"out of bounds", 5, 0, slice.ErrIndexOutOfRange},
+		{"negative index", -1, 3, nil},
+		{"negative out of bounds", -4, 0, slice.ErrIndexOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := slice.AtErr([]int{1, 2, 3}, tt.index)
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Errorf("AtErr(%d) = %v, %v, want %v, %v", tt.index, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRemoveAtErr(t *testing.T) {
+	tests := []struct {
+		name    string
+		index   int
+		want    []int
+		wantErr error
+	}{
+		{"valid index", 1, []int{1, 3}, nil},
+		{"out of bounds", 3, []int{1, 2, 3}, slice.ErrIndexOutOfRange},
+		{"negative index", -3, []int{2, 3}, nil},
+		{"negative out of bounds", -4, []int{1, 2, 3}, slice.ErrIndexOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := slice.RemoveAtErr([]int{1, 2, 3}, tt.index)
+			if !reflect.DeepEqual(got, tt.want) || !errors.Is(err, tt.wantErr) {
+				t.Errorf("RemoveAtErr(%d) = %v, %v, want %v, %v", tt.index, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSliceErr(t *testing.T) {
+	tests := []struct {
+		name    string
+		indexes []int
+		want    []int
+		wantErr error
+	}{
+		{"valid", []int{1, 3}, []int{2, 3}, nil},
+		{"step zero", []int{0, 3, 0}, nil, slice.ErrInvalidStep},
+		{"step negative", []int{0, 3, -1}, nil, slice.ErrInvalidStep},
+		{"begin beyond length", []int{6}, nil, slice.ErrIndexOutOfRange},
+		{"negative begin", []int{-2}, []int{4, 5}, nil},
+		{"negative begin beyond length", []int{-6}, nil, slice.ErrIndexOutOfRange},
+		{"begin after end", []int{3, 1}, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := slice.SliceErr([]int{1, 2, 3, 4, 5}, tt.indexes...)
+			if !reflect.DeepEqual(got, tt.want) || !errors.Is(err, tt.wantErr) {
+				t.Errorf("SliceErr(%v) = %v, %v, want %v, %v", tt.indexes, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestAdvancedSliceErrVariants runs the error-returning methods against every IAdvancedSlice implementation.
+func TestAdvancedSliceErrVariants(t *testing.T) {
+	impls := map[string]func(data ...int) slice.IAdvancedSlice[int]{
+		"advancedSlice": func(data ...int) slice.IAdvancedSlice[int] { return slice.NewAdvancedSlice(data...) },
+		"Deque":         func(data ...int) slice.IAdvancedSlice[int] { return slice.NewDeque(data...) },
+		"IndexedSlice": func(data ...int) slice.IAdvancedSlice[int] {
+			return slice.NewIndexedSlice(func(v int) int { return v }, data...)
+		},
+		"BoundedSlice": func(data ...int) slice.IAdvancedSlice[int] {
+			return slice.NewBoundedSlice(10, slice.OverflowEvict, data...)
+		},
+		"SegmentedSlice":  func(data ...int) slice.IAdvancedSlice[int] { return slice.NewSegmentedSlice(2, data...) },
+		"ObservableSlice": func(data ...int) slice.IAdvancedSlice[int] { return slice.NewObservableSlice(data...) },
+		"HistorySlice":    func(data ...int) slice.IAdvancedSlice[int] { return slice.NewHistorySlice(0, data...) },
+	}
+	for name, newSlice := range impls {
+		// The Err variants report problems as errors under every policy, including BoundsPanic.
+		for policyName, policy := range map[string]slice.BoundsPolicy{"zero": slice.BoundsZero, "panic": slice.BoundsPanic} {
+			t.Run(name+"/"+policyName, func(t *testing.T) {
+				testErrVariants(t, newSlice, policy)
+			})
+		}
+	}
+}
+
+func testErrVariants(t *testing.T, newSlice func(data ...int) slice.IAdvancedSlice[int], policy slice.BoundsPolicy) {
+	t.Helper()
+	s := newSlice(1, 2, 3)
+	s.SetBoundsPolicy(policy)
+	if v, err := s.AtErr(1); v != 2 || err != nil {
+		t.Errorf("AtErr(1) = %v, %v", v, err)
+	}
+	if _, err := s.AtErr(3); !errors.Is(err, slice.ErrIndexOutOfRange) {
+		t.Errorf("AtErr(3) error = %v", err)
+	}
+	if r, err := s.RemoveAtErr(7); r != s || !errors.Is(err, slice.ErrIndexOutOfRange) {
+		t.Errorf("RemoveAtErr(7) error = %v", err)
+	}
+	if _, err := s.SliceErr(0, 3, 0); !errors.Is(err, slice.ErrInvalidStep) {
+		t.Errorf("SliceErr(0, 3, 0) error = %v", err)
+	}
+	if _, err := s.RemoveAtErr(0); err != nil {
+		t.Errorf("RemoveAtErr(0) error = %v", err)
+	}
+	if v, err := s.PopErr(); v != 3 || err != nil {
+		t.Errorf("PopErr() = %v, %v", v, err)
+	}
+	if v, err := s.ShiftErr(); v != 2 || err != nil {
+		t.Errorf("ShiftErr() = %v, %v", v, err)
+	}
+	if _, err := s.PopErr(); !errors.Is(err, slice.ErrEmpty) {
+		t.Errorf("PopErr() on empty error = %v", err)
+	}
+	if _, err := s.ShiftErr(); !errors.Is(err, slice.ErrEmpty) {
+		t.Errorf("ShiftErr() on empty error = %v", err)
+	}
+}
+
+func TestAdvancedSliceBoundsPolicy(t *testing.T) {
+	t.Run("error", func(t *testing.T) {
+		s := slice.NewAdvancedSlice(1, 2, 3)
+		s.SetBoundsPolicy(slice.BoundsError)
+		if v := s.At(5); v != 0 {
+			t.Errorf("At(5) = %v, want 0", v)
+		}
+		if err := s.Err(); !errors.Is(err, slice.ErrIndexOutOfRange) {
+			t.Errorf("Err() = %v, want ErrIndexOutOfRange", err)
+		}
+		if err := s.Err(); err != nil {
+			t.Errorf("Err() = %v after it was read", err)
+		}
+		s.CopyWithIn(0, 9)
+		if err := s.Err(); !errors.Is(err, slice.ErrIndexOutOfRange) {
+			t.Errorf("Err() after CopyWithIn = %v", err)
+		}
+		s.Pop()
+		s.Pop()
+		if err := s.Err(); !errors.Is(err, slice.ErrEmpty) {
+			t.Errorf("Err() after Pop on empty = %v", err)
+		}
+	})
+	t.Run("clamp", func(t *testing.T) {
+		s := slice.NewDeque(1, 2, 3)
+		s.SetBoundsPolicy(slice.BoundsClamp)
+		if v, err := s.AtErr(-4); v != 1 || err != nil {
+			t.Errorf("AtErr(-4) = %v, %v, want 1, nil", v, err)
+		}
+		if got := s.CopyWithIn(5, -1, -7).Values(); !reflect.DeepEqual(got, []int{3, 3, 1}) {
+			t.Errorf("CopyWithIn(5, -1, -7) = %v, want [3 3 1]", got)
+		}
+	})
+	t.Run("panic", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Shift() on empty slice did not panic")
+			}
+		}()
+		s := slice.NewAdvancedSlice[int]()
+		s.SetBoundsPolicy(slice.BoundsPanic)
+		s.Shift()
+	})
+}
+
+func TestFillBoundsPolicy(t *testing.T) {
+	impls := map[string]func(data ...int) slice.IAdvancedSlice[int]{
+		"advancedSlice":   func(data ...int) slice.IAdvancedSlice[int] { return slice.NewAdvancedSlice(data...) },
+		"Deque":           func(data ...int) slice.IAdvancedSlice[int] { return slice.NewDeque(data...) },
+		"SegmentedSlice":  func(data ...int) slice.IAdvancedSlice[int] { return slice.NewSegmentedSlice(2, data...) },
+		"ObservableSlice": func(data ...int) slice.IAdvancedSlice[int] { return slice.NewObservableSlice(data...) },
+		"HistorySlice":    func(data ...int) slice.IAdvancedSlice[int] { return slice.NewHistorySlice(0, data...) },
+	}
+	tests := []struct {
+		name    string
+		policy  slice.BoundsPolicy
+		index   []int
+		want    []int
+		wantErr error
+	}{
+		{"zero clamps", slice.BoundsZero, []int{1, 10}, []int{1, 0, 0}, nil},
+		{"clamp", slice.BoundsClamp, []int{-10, 2}, []int{0, 0, 3}, nil},
+		{"error records begin", slice.BoundsError, []int{10}, []int{1, 2, 3}, slice.ErrIndexOutOfRange},
+		{"error records end", slice.BoundsError, []int{1, 10}, []int{1, 0, 0}, slice.ErrIndexOutOfRange},
+		{"error in range", slice.BoundsError, []int{-2, 3}, []int{1, 0, 0}, nil},
+		{"empty range", slice.BoundsError, []int{2, 1}, []int{1, 2, 3}, nil},
+	}
+	for name, newSlice := range impls {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				s := newSlice(1, 2, 3)
+				s.SetBoundsPolicy(tt.policy)
+				if got := s.Fill(0, tt.index...).Values(); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Fill(0, %v) = %v, want %v", tt.index, got, tt.want)
+				}
+				if err := s.Err(); !errors.Is(err, tt.wantErr) {
+					t.Errorf("Err() = %v, want %v", err, tt.wantErr)
+				}
+			})
+		}
+		t.Run(name+"/panic", func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Fill(0, 1, 10) did not panic")
+				}
+			}()
+			s := newSlice(1, 2, 3)
+			s.SetBoundsPolicy(slice.BoundsPanic)
+			s.Fill(0, 1, 10)
+		})
+	}
+}
+
+func TestNegativeIndexes(t *testing.T) {
+	impls := map[string]func(data ...int) slice.IAdvancedSlice[int]{
+		"advancedSlice":   func(data ...int) slice.IAdvancedSlice[int] { return slice.NewAdvancedSlice(data...) },
+		"Deque":           func(data ...int) slice.IAdvancedSlice[int] { return slice.NewDeque(data...) },
+		"SegmentedSlice":  func(data ...int) slice.IAdvancedSlice[int] { return slice.NewSegmentedSlice(2, data...) },
+		"ObservableSlice": func(data ...int) slice.IAdvancedSlice[int] { return slice.NewObservableSlice(data...) },
+		"HistorySlice":    func(data ...int) slice.IAdvancedSlice[int] { return slice.NewHistorySlice(0, data...) },
+		"DurableSlice": func(data ...int) slice.IAdvancedSlice[int] {
+			s, err := slice.OpenDurableSlice[int](t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { s.Close() })
+			return s.Push(data...)
+		},
+	}
+	for name, newSlice := range impls {
+		t.Run(name, func(t *testing.T) {
+			s := newSlice(1, 2, 3, 4)
+			if v := s.At(-1); v != 4 {
+				t.Errorf("At(-1) = %v, want 4", v)
+			}
+			if v, err := s.AtErr(-4); v != 1 || err != nil {
+				t.Errorf("AtErr(-4) = %v, %v, want 1, nil", v, err)
+			}
+			if _, err := s.AtErr(-5); !errors.Is(err, slice.ErrIndexOutOfRange) {
+				t.Errorf("AtErr(-5) error = %v, want ErrIndexOutOfRange", err)
+			}
+			if got, want := s.RemoveAt(-1).Values(), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+				t.Errorf("RemoveAt(-1) = %v, want %v", got, want)
+			}
+			if r, err := s.RemoveAtErr(-3); err != nil || !reflect.DeepEqual(r.Values(), []int{2, 3}) {
+				t.Errorf("RemoveAtErr(-3) = %v, %v, want [2 3], nil", r.Values(), err)
+			}
+			if _, err := s.RemoveAtErr(-3); !errors.Is(err, slice.ErrIndexOutOfRange) {
+				t.Errorf("RemoveAtErr(-3) on two elements error = %v, want ErrIndexOutOfRange", err)
+			}
+		})
+	}
+}
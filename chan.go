@@ -0,0 +1,141 @@
+package slice
+
+import (
+	"context"
+	"sync"
+)
+
+// FromChan collects the values received from a channel into an advanced slice.
+// It returns when the channel is closed or the context is cancelled.
+//
+// Parameters:
+//   - ctx: The context; cancelling it stops collection.
+//   - ch: The channel to receive from.
+//
+// Returns:
+//
+//   - IAdvancedSlice[T]: The values received, in order, including those received before cancellation.
+//   - error: The context error if ctx was cancelled before ch was closed, otherwise nil.
+func FromChan[T any](ctx context.Context, ch <-chan T) (IAdvancedSlice[T], error) {
+	var list []T
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return NewAdvancedSlice(list...), nil
+			}
+			list = append(list, v)
+		case <-ctx.Done():
+			return NewAdvancedSlice(list...), ctx.Err()
+		}
+	}
+}
+
+// ToChan sends the elements of the slice on a new channel, in order, and closes it.
+// The elements are copied when ToChan is called, so later changes to s are not seen.
+// If ctx is cancelled the channel is closed early; the sending goroutine never outlives ctx.
+//
+// Parameters:
+//   - ctx: The context; cancelling it stops sending.
+//   - s: The slice to send.
+//   - buffer: The capacity of the returned channel.
+//
+// Returns:
+//
+//   - <-chan T: The channel of elements.
+func ToChan[T any](ctx context.Context, s IAdvancedSlice[T], buffer int) <-chan T {
+	data := append([]T(nil), s.Values()...)
+	out := make(chan T, max(buffer, 0))
+	go func() {
+		defer close(out)
+		for _, v := range data {
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FanOut spreads the elements of the slice across n channels for parallel consumers.
+// Each element is sent on exactly one channel, whichever consumer is ready first, so a slow
+// consumer receives fewer elements. All channels are closed once every element has been sent
+// or ctx is cancelled. Consumers should drain their channels or cancel ctx.
+//
+// Parameters:
+//   - ctx: The context; cancelling it stops sending.
+//   - s: The slice to distribute.
+//   - n: The number of channels. Values below 1 are treated as 1.
+//
+// Returns:
+//
+//   - []<-chan T: The n unbuffered channels.
+//
+// Example:
+//
+//	for _, ch := range FanOut(ctx, jobs, 4) {
+//		go worker(ch)
+//	}
+func FanOut[T any](ctx context.Context, s IAdvancedSlice[T], n int) []<-chan T {
+	src := ToChan(ctx, s, 0)
+	outs := make([]<-chan T, max(n, 1))
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			for v := range src {
+				select {
+				case out <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	return outs
+}
+
+// Merge forwards the values of several channels onto one channel, closing it once every input is closed
+// or ctx is cancelled. Values from one input keep their order; values from different inputs interleave.
+// Merge does not drain inputs after cancellation; that remains the job of their senders.
+//
+// Parameters:
+//   - ctx: The context; cancelling it stops forwarding.
+//   - chs: The channels to merge.
+//
+// Returns:
+//
+//   - <-chan T: The merged channel.
+func Merge[T any](ctx context.Context, chs ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, ch := range chs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case v, ok := <-ch:
+					if !ok {
+						return
+					}
+					select {
+					case out <- v:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
@@ -0,0 +1,146 @@
+package slice_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aide-cloud/slice"
+)
+
+// checkLeaks fails the test if goroutines started during it are still running shortly after it ends.
+func checkLeaks(t *testing.T) {
+	t.Helper()
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before {
+			if time.Now().After(deadline) {
+				buf := make([]byte, 1<<16)
+				t.Errorf("leaked goroutines: %d before, %d after\n%s", before, runtime.NumGoroutine(), buf[:runtime.Stack(buf, true)])
+				return
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	})
+}
+
+func TestFromChanToChan(t *testing.T) {
+	checkLeaks(t)
+	ctx := context.Background()
+	got, err := slice.FromChan(ctx, slice.ToChan(ctx, slice.NewAdvancedSlice(1, 2, 3), 1))
+	if err != nil || !reflect.DeepEqual(got.Values(), []int{1, 2, 3}) {
+		t.Errorf("FromChan(ToChan()) = %v, %v", got, err)
+	}
+}
+
+func TestFromChanCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	got, err := slice.FromChan(ctx, ch)
+	if !errors.Is(err, context.Canceled) || !reflect.DeepEqual(got.Values(), []int{1, 2}) {
+		t.Errorf("FromChan() = %v, %v, want [1 2], context.Canceled", got, err)
+	}
+}
+
+func TestToChanCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := slice.ToChan(ctx, slice.NewAdvancedSlice(1, 2, 3, 4, 5), 0)
+	if v := <-ch; v != 1 {
+		t.Errorf("first value = %d, want 1", v)
+	}
+	cancel()
+	// The channel closes even though nobody reads the remaining values.
+	for range ch {
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	checkLeaks(t)
+	data := make([]int, 100)
+	for i := range data {
+		data[i] = i
+	}
+	s := slice.NewAdvancedSlice(data...)
+	outs := slice.FanOut(context.Background(), s, 4)
+	if len(outs) != 4 {
+		t.Fatalf("FanOut() returned %d channels, want 4", len(outs))
+	}
+	var mu sync.Mutex
+	var got []int
+	var wg sync.WaitGroup
+	for _, ch := range outs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range ch {
+				mu.Lock()
+				got = append(got, v)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	slices.Sort(got)
+	if !reflect.DeepEqual(got, s.Values()) {
+		t.Errorf("FanOut() delivered %v", got)
+	}
+}
+
+func TestFanOutCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	outs := slice.FanOut(ctx, slice.NewAdvancedSlice(1, 2, 3, 4, 5, 6), 3)
+	<-outs[0]
+	cancel()
+	for _, ch := range outs {
+		for range ch {
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	checkLeaks(t)
+	ctx := context.Background()
+	merged := slice.Merge(ctx,
+		slice.ToChan(ctx, slice.NewAdvancedSlice(1, 2, 3), 0),
+		slice.ToChan(ctx, slice.NewAdvancedSlice(4, 5), 0),
+		slice.ToChan(ctx, slice.NewAdvancedSlice[int](), 0),
+	)
+	got, err := slice.FromChan(ctx, merged)
+	if err != nil {
+		t.Fatalf("FromChan() error = %v", err)
+	}
+	values := got.Values()
+	slices.Sort(values)
+	if !reflect.DeepEqual(values, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Merge() = %v", got.Values())
+	}
+	if _, ok := <-slice.Merge[int](ctx); ok {
+		t.Error("Merge() with no inputs did not close")
+	}
+}
+
+func TestMergeCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	never := make(chan int)
+	merged := slice.Merge(ctx, never, slice.ToChan(ctx, slice.NewAdvancedSlice(1, 2, 3), 0))
+	<-merged
+	cancel()
+	for range merged {
+	}
+}
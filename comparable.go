@@ -0,0 +1,350 @@
+package slice
+
+import "math/rand/v2"
+
+// Contains reports whether the value is present in the slice.
+//
+// Parameters:
+//   - s: The slice to search.
+//   - v: The value to look for.
+//
+// Returns:
+//
+//	true if the slice contains v, false otherwise.
+func Contains[T comparable](s []T, v T) bool {
+	return IndexOf(s, v) >= 0
+}
+
+// IndexOf returns the index of the first occurrence of the value in the slice.
+//
+// Parameters:
+//   - s: The slice to search.
+//   - v: The value to look for.
+//
+// Returns:
+//
+//	The index of the first occurrence of v, or -1 if it is not present.
+func IndexOf[T comparable](s []T, v T) int {
+	for i, item := range s {
+		if item == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexOf returns the index of the last occurrence of the value in the slice.
+//
+// Parameters:
+//   - s: The slice to search.
+//   - v: The value to look for.
+//
+// Returns:
+//
+//	The index of the last occurrence of v, or -1 if it is not present.
+func LastIndexOf[T comparable](s []T, v T) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// Count returns the number of occurrences of the value in the slice.
+//
+// Parameters:
+//   - s: The slice to search.
+//   - v: The value to count.
+//
+// Returns:
+//
+//	The number of elements equal to v.
+func Count[T comparable](s []T, v T) int {
+	n := 0
+	for _, item := range s {
+		if item == v {
+			n++
+		}
+	}
+	return n
+}
+
+// Equal reports whether two slices have the same length and equal elements in the same order.
+//
+// Parameters:
+//   - a: The first slice.
+//   - b: The second slice.
+//
+// Returns:
+//
+//	true if the slices are equal, false otherwise.
+func Equal[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAll reports whether every one of the values is present in the slice.
+//
+// Parameters:
+//   - s: The slice to search.
+//   - values: The values to look for.
+//
+// Returns:
+//
+//	true if all values are present, or if no values are given.
+func ContainsAll[T comparable](s []T, values ...T) bool {
+	set := toSet(s)
+	for _, v := range values {
+		if _, ok := set[v]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny reports whether at least one of the values is present in the slice.
+//
+// Parameters:
+//   - s: The slice to search.
+//   - values: The values to look for.
+//
+// Returns:
+//
+//	true if any value is present, false otherwise or if no values are given.
+func ContainsAny[T comparable](s []T, values ...T) bool {
+	set := toSet(values)
+	for _, item := range s {
+		if _, ok := set[item]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Replace replaces every occurrence of old with new.
+//
+// Parameters:
+//   - s: The slice to modify.
+//   - old: The value to replace.
+//   - new: The replacement value.
+//
+// Returns:
+//
+//	The modified slice.
+func Replace[T comparable](s []T, old, new T) []T {
+	for i, item := range s {
+		if item == old {
+			s[i] = new
+		}
+	}
+	return s
+}
+
+// Distinct returns a new slice with duplicate values removed, keeping the first occurrence of each.
+// It is Unique with the element itself as the key.
+//
+// Parameters:
+//   - s: The original slice.
+//
+// Returns:
+//
+//	A new slice containing only the first occurrence of each value.
+func Distinct[T comparable](s []T) []T {
+	return Unique(s, func(v T) T { return v })
+}
+
+// toSet builds a set from the elements of a slice.
+func toSet[T comparable](s []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(s))
+	for _, v := range s {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+var _ IAdvancedSlice[int] = (*ComparableSlice[int])(nil)
+
+// ComparableSlice is an advanced slice of comparable elements,
+// adding value-based lookups that need no predicate.
+type ComparableSlice[T comparable] struct {
+	*advancedSlice[T]
+}
+
+// NewComparableSlice creates a new comparable slice.
+//
+// Parameters:
+//   - data: The initial elements.
+//
+// Returns:
+//
+//   - *ComparableSlice[T]: The comparable slice.
+//
+// Example:
+//
+//	s := NewComparableSlice("a", "b", "a")
+//	s.Contains("b") // true
+//	s.Unique(nil)   // ["a", "b"]
+func NewComparableSlice[T comparable](data ...T) *ComparableSlice[T] {
+	return &ComparableSlice[T]{advancedSlice: &advancedSlice[T]{data: data}}
+}
+
+// Contains reports whether the value is present.
+func (s *ComparableSlice[T]) Contains(v T) bool {
+	return Contains(s.data, v)
+}
+
+// IndexOf returns the index of the first occurrence of the value, or -1.
+func (s *ComparableSlice[T]) IndexOf(v T) int {
+	return IndexOf(s.data, v)
+}
+
+// LastIndexOf returns the index of the last occurrence of the value, or -1.
+func (s *ComparableSlice[T]) LastIndexOf(v T) int {
+	return LastIndexOf(s.data, v)
+}
+
+// Count returns the number of occurrences of the value.
+func (s *ComparableSlice[T]) Count(v T) int {
+	return Count(s.data, v)
+}
+
+// Equal reports whether the other slice has the same elements in the same order.
+func (s *ComparableSlice[T]) Equal(other IAdvancedSlice[T]) bool {
+	return Equal(s.data, other.Values())
+}
+
+// ContainsAll reports whether every one of the values is present.
+func (s *ComparableSlice[T]) ContainsAll(values ...T) bool {
+	return ContainsAll(s.data, values...)
+}
+
+// ContainsAny reports whether at least one of the values is present.
+func (s *ComparableSlice[T]) ContainsAny(values ...T) bool {
+	return ContainsAny(s.data, values...)
+}
+
+// Replace replaces every occurrence of old with new.
+func (s *ComparableSlice[T]) Replace(old, new T) IAdvancedSlice[T] {
+	s.data = Replace(s.data, old, new)
+	return s
+}
+
+// Unique keeps the first occurrence of each key returned by f.
+// If f is nil the elements themselves are compared, so no key function is needed.
+func (s *ComparableSlice[T]) Unique(f func(T) string) IAdvancedSlice[T] {
+	if f == nil && s.key == nil {
+		s.data = Distinct(s.data)
+		return s
+	}
+	s.advancedSlice.Unique(f)
+	return s
+}
+
+// Map replaces each element with the result of f.
+func (s *ComparableSlice[T]) Map(f func(T, int) T) IAdvancedSlice[T] {
+	s.advancedSlice.Map(f)
+	return s
+}
+
+// Concat appends the elements of the given slices.
+func (s *ComparableSlice[T]) Concat(ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	s.advancedSlice.Concat(ss...)
+	return s
+}
+
+// CopyWithIn keeps only the elements at the given indices.
+func (s *ComparableSlice[T]) CopyWithIn(indexes ...int) IAdvancedSlice[T] {
+	s.advancedSlice.CopyWithIn(indexes...)
+	return s
+}
+
+// Slice keeps only the selected subset.
+func (s *ComparableSlice[T]) Slice(index ...int) IAdvancedSlice[T] {
+	s.advancedSlice.Slice(index...)
+	return s
+}
+
+// Fill sets the selected elements to value.
+func (s *ComparableSlice[T]) Fill(value T, index ...int) IAdvancedSlice[T] {
+	s.advancedSlice.Fill(value, index...)
+	return s
+}
+
+// Sort sorts the elements using f.
+func (s *ComparableSlice[T]) Sort(f func(T, T) bool) IAdvancedSlice[T] {
+	s.advancedSlice.Sort(f)
+	return s
+}
+
+// Push appends one or more elements.
+func (s *ComparableSlice[T]) Push(values ...T) IAdvancedSlice[T] {
+	s.advancedSlice.Push(values...)
+	return s
+}
+
+// PushSlice appends the elements of the given slices.
+func (s *ComparableSlice[T]) PushSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	s.advancedSlice.PushSlice(values...)
+	return s
+}
+
+// Unshift prepends one or more elements.
+func (s *ComparableSlice[T]) Unshift(values ...T) IAdvancedSlice[T] {
+	s.advancedSlice.Unshift(values...)
+	return s
+}
+
+// UnshiftSlice prepends the elements of each given slice in turn.
+func (s *ComparableSlice[T]) UnshiftSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	s.advancedSlice.UnshiftSlice(values...)
+	return s
+}
+
+// Reverse reverses the order of the elements.
+func (s *ComparableSlice[T]) Reverse() IAdvancedSlice[T] {
+	s.advancedSlice.Reverse()
+	return s
+}
+
+// Shuffle randomly permutes the elements in place.
+func (s *ComparableSlice[T]) Shuffle(r *rand.Rand) IAdvancedSlice[T] {
+	s.advancedSlice.Shuffle(r)
+	return s
+}
+
+// Remove removes the elements that satisfy f.
+func (s *ComparableSlice[T]) Remove(f func(T, int) bool) IAdvancedSlice[T] {
+	s.advancedSlice.Remove(f)
+	return s
+}
+
+// RemoveAt removes the element at index.
+func (s *ComparableSlice[T]) RemoveAt(index int) IAdvancedSlice[T] {
+	s.advancedSlice.RemoveAt(index)
+	return s
+}
+
+// RemoveAtErr removes the element at index, or returns an error as RemoveAtErr does.
+func (s *ComparableSlice[T]) RemoveAtErr(index int) (IAdvancedSlice[T], error) {
+	_, err := s.advancedSlice.RemoveAtErr(index)
+	return s, err
+}
+
+// SliceErr keeps only the selected subset, or returns an error as SliceErr does.
+func (s *ComparableSlice[T]) SliceErr(index ...int) (IAdvancedSlice[T], error) {
+	_, err := s.advancedSlice.SliceErr(index...)
+	return s, err
+}
+
+// Begin starts a transaction over the slice.
+func (s *ComparableSlice[T]) Begin(validators ...Validator[T]) *Tx[T] {
+	return Begin[T](s, validators...)
+}
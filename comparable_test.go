@@ -0,0 +1,117 @@
+package slice_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestContainsIndexOfCount(t *testing.T) {
+	s := []string{"a", "b", "a", "c"}
+	tests := []struct {
+		name     string
+		v        string
+		contains bool
+		index    int
+		last     int
+		count    int
+	}{
+		{"repeated", "a", true, 0, 2, 2},
+		{"single", "c", true, 3, 3, 1},
+		{"missing", "z", false, -1, -1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slice.Contains(s, tt.v); got != tt.contains {
+				t.Errorf("Contains(%q) = %v, want %v", tt.v, got, tt.contains)
+			}
+			if got := slice.IndexOf(s, tt.v); got != tt.index {
+				t.Errorf("IndexOf(%q) = %v, want %v", tt.v, got, tt.index)
+			}
+			if got := slice.LastIndexOf(s, tt.v); got != tt.last {
+				t.Errorf("LastIndexOf(%q) = %v, want %v", tt.v, got, tt.last)
+			}
+			if got := slice.Count(s, tt.v); got != tt.count {
+				t.Errorf("Count(%q) = %v, want %v", tt.v, got, tt.count)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want bool
+	}{
+		{"both empty", nil, []int{}, true},
+		{"equal", []int{1, 2}, []int{1, 2}, true},
+		{"different order", []int{1, 2}, []int{2, 1}, false},
+		{"different length", []int{1}, []int{1, 2}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slice.Equal(tt.a, tt.b); got != tt.want {
+				t.Errorf("Equal(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainsAllAny(t *testing.T) {
+	s := []int{1, 2, 3}
+	tests := []struct {
+		name    string
+		values  []int
+		wantAll bool
+		wantAny bool
+	}{
+		{"none given", nil, true, false},
+		{"all present", []int{3, 1}, true, true},
+		{"some present", []int{1, 9}, false, true},
+		{"none present", []int{8, 9}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slice.ContainsAll(s, tt.values...); got != tt.wantAll {
+				t.Errorf("ContainsAll(%v) = %v, want %v", tt.values, got, tt.wantAll)
+			}
+			if got := slice.ContainsAny(s, tt.values...); got != tt.wantAny {
+				t.Errorf("ContainsAny(%v) = %v, want %v", tt.values, got, tt.wantAny)
+			}
+		})
+	}
+}
+
+func TestReplaceDistinct(t *testing.T) {
+	if got, want := slice.Replace([]int{1, 2, 1}, 1, 5), []int{5, 2, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Replace() = %v, want %v", got, want)
+	}
+	if got, want := slice.Distinct([]int{3, 1, 3, 2, 1}), []int{3, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Distinct() = %v, want %v", got, want)
+	}
+}
+
+func TestComparableSlice(t *testing.T) {
+	s := slice.NewComparableSlice("a", "b", "a", "c")
+	if !s.Contains("c") || s.IndexOf("a") != 0 || s.LastIndexOf("a") != 2 || s.Count("a") != 2 {
+		t.Errorf("lookups on %v returned unexpected results", s)
+	}
+	if !s.ContainsAll("a", "c") || s.ContainsAny("x", "y") {
+		t.Errorf("ContainsAll/ContainsAny on %v returned unexpected results", s)
+	}
+
+	var as slice.IAdvancedSlice[string] = s
+	as = as.Unique(nil)
+	if got, want := as.Values(), []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unique(nil) = %v, want %v", got, want)
+	}
+	if _, ok := as.Push("d").(*slice.ComparableSlice[string]); !ok {
+		t.Errorf("Push() did not return the ComparableSlice")
+	}
+	s.Replace("d", "a")
+	if !s.Equal(slice.NewAdvancedSlice("a", "b", "c", "a")) {
+		t.Errorf("Equal() = false for %v", s)
+	}
+}
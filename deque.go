@@ -0,0 +1,538 @@
+package slice
+
+import "math/rand/v2"
+
+// minDequeCapacity is the smallest buffer a Deque allocates and the size below which it never shrinks.
+const minDequeCapacity = 8
+
+var _ IAdvancedSlice[any] = (*Deque[any])(nil)
+
+// Deque is a double-ended queue backed by a ring buffer.
+// Push, Pop, Shift and Unshift run in amortized O(1); the buffer grows by doubling
+// and shrinks by half once it is at most a quarter full.
+type Deque[T any] struct {
+	buf    []T
+	head   int
+	size   int
+	less   func(a, b T) bool
+	key    func(T) string
+	bounds BoundsPolicy
+	err    error
+}
+
+// NewDeque creates a new deque.
+//
+// Parameters:
+//   - data: The initial elements, front to back.
+//
+// Returns:
+//
+//   - *Deque[T]: The deque.
+//
+// Example:
+//
+//	d := NewDeque(1, 2, 3)
+//	d.Unshift(0)
+//	first, _ := d.PeekFront() // 0
+func NewDeque[T any](data ...T) *Deque[T] {
+	d := &Deque[T]{}
+	d.setValues(append([]T(nil), data...))
+	return d
+}
+
+// index maps a logical position to a position in the buffer.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+// resize moves the elements into a new buffer of the given capacity.
+func (d *Deque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	d.copyTo(buf)
+	d.buf = buf
+	d.head = 0
+}
+
+// copyTo copies the elements in order into dst, which must hold at least d.size elements.
+func (d *Deque[T]) copyTo(dst []T) {
+	if d.size == 0 {
+		return
+	}
+	n := copy(dst, d.buf[d.head:min(d.head+d.size, len(d.buf))])
+	copy(dst[n:], d.buf[:d.size-n])
+}
+
+// grow makes room for at least one more element.
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+	d.resize(max(2*len(d.buf), minDequeCapacity))
+}
+
+// shrink halves the buffer when it is at most a quarter full.
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minDequeCapacity && d.size <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+// values returns the elements in order as a new slice.
+func (d *Deque[T]) values() []T {
+	list := make([]T, d.size)
+	d.copyTo(list)
+	return list
+}
+
+// setValues replaces the contents of the deque, taking ownership of data.
+func (d *Deque[T]) setValues(data []T) {
+	d.buf = data
+	d.head = 0
+	d.size = len(data)
+}
+
+// pushBack appends one element.
+func (d *Deque[T]) pushBack(v T) {
+	d.grow()
+	d.buf[d.index(d.size)] = v
+	d.size++
+}
+
+// pushFront prepends one element.
+func (d *Deque[T]) pushFront(v T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = v
+	d.size++
+}
+
+// resolve applies the bounds policy to an index, recording an error under BoundsError.
+func (d *Deque[T]) resolve(index int) (int, bool) {
+	i, ok := resolveIndex(d.size, index, d.bounds)
+	if !ok && d.bounds == BoundsError {
+		d.err = indexError(index, d.size)
+	}
+	return i, ok
+}
+
+// empty applies the bounds policy to a removal from an empty deque.
+func (d *Deque[T]) empty() {
+	switch d.bounds {
+	case BoundsPanic:
+		panic(ErrEmpty)
+	case BoundsError:
+		d.err = ErrEmpty
+	}
+}
+
+// String returns a JSON string representation of the deque.
+func (d *Deque[T]) String() string {
+	return String(d.values())
+}
+
+// Length returns the number of elements in the deque.
+func (d *Deque[T]) Length() int {
+	return d.size
+}
+
+// Map replaces each element with the result of f.
+func (d *Deque[T]) Map(f func(T, int) T) IAdvancedSlice[T] {
+	for i := 0; i < d.size; i++ {
+		j := d.index(i)
+		d.buf[j] = f(d.buf[j], i)
+	}
+	return d
+}
+
+// Unique keeps the first occurrence of each key returned by f, or by the configured key if f is nil.
+func (d *Deque[T]) Unique(f func(T) string) IAdvancedSlice[T] {
+	if f == nil {
+		f = d.key
+	}
+	if f == nil {
+		return d
+	}
+	d.setValues(Unique(d.values(), f))
+	return d
+}
+
+// Concat appends the elements of the given slices.
+func (d *Deque[T]) Concat(ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	return d.PushSlice(ss...)
+}
+
+// CopyWithIn keeps only the elements at the given indices.
+func (d *Deque[T]) CopyWithIn(indexes ...int) IAdvancedSlice[T] {
+	resolved, err := resolveIndexes(d.size, indexes, d.bounds)
+	if err != nil && d.bounds == BoundsError {
+		d.err = err
+	}
+	d.setValues(CopyWithIn(d.values(), resolved...))
+	return d
+}
+
+// Every checks if all elements satisfy f.
+func (d *Deque[T]) Every(f func(T) bool) bool {
+	for i := 0; i < d.size; i++ {
+		if !f(d.buf[d.index(i)]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find returns the first element that satisfies f, or the zero value.
+func (d *Deque[T]) Find(f func(T) bool) T {
+	if i := d.FindIndex(f); i >= 0 {
+		return d.buf[d.index(i)]
+	}
+	var zero T
+	return zero
+}
+
+// FindIndex returns the index of the first element that satisfies f, or -1.
+func (d *Deque[T]) FindIndex(f func(T) bool) int {
+	for i := 0; i < d.size; i++ {
+		if f(d.buf[d.index(i)]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindLast returns the last element that satisfies f, or the zero value.
+func (d *Deque[T]) FindLast(f func(T) bool) T {
+	if i := d.FindLastIndex(f); i >= 0 {
+		return d.buf[d.index(i)]
+	}
+	var zero T
+	return zero
+}
+
+// FindLastIndex returns the index of the last element that satisfies f, or -1.
+func (d *Deque[T]) FindLastIndex(f func(T) bool) int {
+	for i := d.size - 1; i >= 0; i-- {
+		if f(d.buf[d.index(i)]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ForEach calls f for each element, front to back.
+func (d *Deque[T]) ForEach(f func(T, int)) {
+	for i := 0; i < d.size; i++ {
+		f(d.buf[d.index(i)], i)
+	}
+}
+
+// Join converts all elements to strings and joins them with an optional separator.
+func (d *Deque[T]) Join(sep ...string) string {
+	return Join(d.values(), sep...)
+}
+
+// Slice keeps only the selected subset.
+func (d *Deque[T]) Slice(index ...int) IAdvancedSlice[T] {
+	d.setValues(Slice(d.values(), index...))
+	return d
+}
+
+// Fill sets the selected elements to value.
+func (d *Deque[T]) Fill(value T, index ...int) IAdvancedSlice[T] {
+	if err := checkRange(d.size, index, d.bounds); err != nil && d.bounds == BoundsError {
+		d.err = err
+	}
+	d.setValues(Fill(d.values(), value, index...))
+	return d
+}
+
+// At returns the element at index, handling out-of-range indices according to the bounds policy.
+func (d *Deque[T]) At(index int) T {
+	i, ok := d.resolve(index)
+	if !ok {
+		var zero T
+		return zero
+	}
+	return d.buf[d.index(i)]
+}
+
+// Sort sorts the elements using f, or the configured comparator if f is nil.
+func (d *Deque[T]) Sort(f func(T, T) bool) IAdvancedSlice[T] {
+	if f == nil {
+		f = d.less
+	}
+	if f == nil {
+		return d
+	}
+	d.setValues(Sort(d.values(), f))
+	return d
+}
+
+// Values returns the elements, front to back, as a new slice.
+func (d *Deque[T]) Values() []T {
+	return d.values()
+}
+
+// Filter returns the elements that satisfy f.
+func (d *Deque[T]) Filter(f func(T, int) bool) []T {
+	list := make([]T, 0, d.size)
+	for i := 0; i < d.size; i++ {
+		if v := d.buf[d.index(i)]; f(v, i) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// Pop removes and returns the last element.
+func (d *Deque[T]) Pop() T {
+	v, ok := d.PopIs()
+	if !ok {
+		d.empty()
+	}
+	return v
+}
+
+// PopIs removes and returns the last element, and whether there was one.
+func (d *Deque[T]) PopIs() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	j := d.index(d.size - 1)
+	v := d.buf[j]
+	d.buf[j] = zero
+	d.size--
+	d.shrink()
+	return v, true
+}
+
+// Push appends one or more elements.
+func (d *Deque[T]) Push(values ...T) IAdvancedSlice[T] {
+	for _, v := range values {
+		d.pushBack(v)
+	}
+	return d
+}
+
+// PushSlice appends the elements of the given slices.
+func (d *Deque[T]) PushSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	for _, v := range values {
+		d.Push(v.Values()...)
+	}
+	return d
+}
+
+// Shift removes and returns the first element.
+func (d *Deque[T]) Shift() T {
+	v, ok := d.ShiftIs()
+	if !ok {
+		d.empty()
+	}
+	return v
+}
+
+// ShiftIs removes and returns the first element, and whether there was one.
+func (d *Deque[T]) ShiftIs() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	v := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = (d.head + 1) % len(d.buf)
+	d.size--
+	d.shrink()
+	return v, true
+}
+
+// Unshift prepends one or more elements, keeping their order.
+func (d *Deque[T]) Unshift(values ...T) IAdvancedSlice[T] {
+	for i := len(values) - 1; i >= 0; i-- {
+		d.pushFront(values[i])
+	}
+	return d
+}
+
+// UnshiftSlice prepends the elements of each given slice in turn.
+func (d *Deque[T]) UnshiftSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	for _, v := range values {
+		d.Unshift(v.Values()...)
+	}
+	return d
+}
+
+// Reverse reverses the order of the elements.
+func (d *Deque[T]) Reverse() IAdvancedSlice[T] {
+	for i, j := 0, d.size-1; i < j; i, j = i+1, j-1 {
+		a, b := d.index(i), d.index(j)
+		d.buf[a], d.buf[b] = d.buf[b], d.buf[a]
+	}
+	return d
+}
+
+// Shuffle randomly permutes the elements in place.
+func (d *Deque[T]) Shuffle(r *rand.Rand) IAdvancedSlice[T] {
+	for i := d.size - 1; i > 0; i-- {
+		a, b := d.index(i), d.index(intN(r, i+1))
+		d.buf[a], d.buf[b] = d.buf[b], d.buf[a]
+	}
+	return d
+}
+
+// Sample returns n distinct elements chosen at random, without replacement.
+func (d *Deque[T]) Sample(n int, r *rand.Rand) []T {
+	return Sample(d.values(), n, r)
+}
+
+// SampleWithReplacement returns n elements chosen independently at random.
+func (d *Deque[T]) SampleWithReplacement(n int, r *rand.Rand) []T {
+	return SampleWithReplacement(d.values(), n, r)
+}
+
+// WeightedChoice chooses one element at random with probability proportional to its weight.
+func (d *Deque[T]) WeightedChoice(weight func(T) float64, r *rand.Rand) (T, bool) {
+	return WeightedChoice(d.values(), weight, r)
+}
+
+// Remove removes the elements that satisfy f.
+func (d *Deque[T]) Remove(f func(T, int) bool) IAdvancedSlice[T] {
+	d.setValues(Remove(d.values(), f))
+	return d
+}
+
+// RemoveAt removes the element at index, handling out-of-range indices according to the bounds policy.
+func (d *Deque[T]) RemoveAt(index int) IAdvancedSlice[T] {
+	if i, ok := d.resolve(index); ok {
+		d.removeAt(i)
+	}
+	return d
+}
+
+// removeAt removes the element at a valid index, moving whichever side of the deque is shorter.
+func (d *Deque[T]) removeAt(i int) {
+	if i < d.size/2 {
+		for j := i; j > 0; j-- {
+			d.buf[d.index(j)] = d.buf[d.index(j-1)]
+		}
+		d.ShiftIs()
+		return
+	}
+	for j := i; j < d.size-1; j++ {
+		d.buf[d.index(j)] = d.buf[d.index(j+1)]
+	}
+	d.PopIs()
+}
+
+// AtErr returns the element at index, or an error wrapping ErrIndexOutOfRange if the bounds policy does not clamp it.
+func (d *Deque[T]) AtErr(index int) (T, error) {
+	i, err := resolveIndexErr(d.size, index, d.bounds)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return d.buf[d.index(i)], nil
+}
+
+// RemoveAtErr removes the element at index, or returns an error wrapping ErrIndexOutOfRange if the bounds policy does not clamp it.
+func (d *Deque[T]) RemoveAtErr(index int) (IAdvancedSlice[T], error) {
+	i, err := resolveIndexErr(d.size, index, d.bounds)
+	if err != nil {
+		return d, err
+	}
+	d.removeAt(i)
+	return d, nil
+}
+
+// PopErr removes and returns the last element, or returns ErrEmpty.
+func (d *Deque[T]) PopErr() (T, error) {
+	v, ok := d.PopIs()
+	if !ok {
+		return v, ErrEmpty
+	}
+	return v, nil
+}
+
+// ShiftErr removes and returns the first element, or returns ErrEmpty.
+func (d *Deque[T]) ShiftErr() (T, error) {
+	v, ok := d.ShiftIs()
+	if !ok {
+		return v, ErrEmpty
+	}
+	return v, nil
+}
+
+// SliceErr keeps only the selected subset, or leaves the deque unchanged and returns an error as SliceErr does.
+func (d *Deque[T]) SliceErr(index ...int) (IAdvancedSlice[T], error) {
+	data, err := SliceErr(d.values(), index...)
+	if err != nil {
+		return d, err
+	}
+	d.setValues(data)
+	return d, nil
+}
+
+// SetBoundsPolicy sets how out-of-range indices and removals from an empty deque are handled.
+func (d *Deque[T]) SetBoundsPolicy(policy BoundsPolicy) {
+	d.bounds = policy
+}
+
+// SetComparator sets the comparison function Sort uses when it is called with nil.
+func (d *Deque[T]) SetComparator(less func(a, b T) bool) {
+	d.less = less
+}
+
+// SetKey sets the key function Unique uses when it is called with nil.
+func (d *Deque[T]) SetKey(key func(T) string) {
+	d.key = key
+}
+
+// Err returns the last error recorded under the BoundsError policy and clears it.
+func (d *Deque[T]) Err() error {
+	err := d.err
+	d.err = nil
+	return err
+}
+
+// PeekFront returns the first element without removing it.
+//
+// Returns:
+//
+//   - T: The first element, or the zero value if the deque is empty.
+//   - bool: Whether the deque was non-empty.
+func (d *Deque[T]) PeekFront() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.head], true
+}
+
+// PeekBack returns the last element without removing it.
+//
+// Returns:
+//
+//   - T: The last element, or the zero value if the deque is empty.
+//   - bool: Whether the deque was non-empty.
+func (d *Deque[T]) PeekBack() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.index(d.size-1)], true
+}
+
+// Begin starts a transaction over the deque.
+func (d *Deque[T]) Begin(validators ...Validator[T]) *Tx[T] {
+	return Begin[T](d, validators...)
+}
+
+// replace swaps in new contents.
+func (d *Deque[T]) replace(values []T) {
+	d.setValues(values)
+}
+
+// config returns the comparator, key function and bounds policy.
+func (d *Deque[T]) config() (func(a, b T) bool, func(T) string, BoundsPolicy) {
+	return d.less, d.key, d.bounds
+}
@@ -0,0 +1,127 @@
+package slice_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestDequePushShift(t *testing.T) {
+	d := slice.NewDeque(3, 4)
+	d.Unshift(1, 2)
+	d.Push(5, 6)
+	if got, want := d.Values(), []int{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Values() = %v, want %v", got, want)
+	}
+	if v, ok := d.PeekFront(); !ok || v != 1 {
+		t.Errorf("PeekFront() = %v, %v", v, ok)
+	}
+	if v, ok := d.PeekBack(); !ok || v != 6 {
+		t.Errorf("PeekBack() = %v, %v", v, ok)
+	}
+	if v := d.Shift(); v != 1 {
+		t.Errorf("Shift() = %v, want 1", v)
+	}
+	if v := d.Pop(); v != 6 {
+		t.Errorf("Pop() = %v, want 6", v)
+	}
+	if got, want := d.Values(), []int{2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+}
+
+func TestDequeEmpty(t *testing.T) {
+	d := slice.NewDeque[int]()
+	if _, ok := d.PopIs(); ok {
+		t.Errorf("PopIs() on empty deque returned ok")
+	}
+	if _, ok := d.ShiftIs(); ok {
+		t.Errorf("ShiftIs() on empty deque returned ok")
+	}
+	if _, ok := d.PeekFront(); ok {
+		t.Errorf("PeekFront() on empty deque returned ok")
+	}
+	if d.String() != "[]" {
+		t.Errorf("String() = %v, want []", d.String())
+	}
+}
+
+func TestDequeWrapAround(t *testing.T) {
+	d := slice.NewDeque[int]()
+	want := make([]int, 0)
+	for i := 0; i < 100; i++ {
+		d.Push(i)
+		want = append(want, i)
+		if i%3 == 0 {
+			d.Shift()
+			want = want[1:]
+		}
+	}
+	if got := d.Values(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Values() = %v, want %v", got, want)
+	}
+	d.Reverse()
+	if d.At(0) != want[len(want)-1] {
+		t.Errorf("At(0) = %v after Reverse, want %v", d.At(0), want[len(want)-1])
+	}
+	for d.Length() > 0 {
+		d.Pop()
+	}
+	d.Unshift(7)
+	if got := d.Values(); !reflect.DeepEqual(got, []int{7}) {
+		t.Errorf("Values() = %v after drain, want [7]", got)
+	}
+}
+
+func TestDequeAdvancedSlice(t *testing.T) {
+	var s slice.IAdvancedSlice[int] = slice.NewDeque(5, 3, 1, 4)
+	s.Sort(func(a, b int) bool { return a < b })
+	if got, want := s.Values(), []int{1, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort() = %v, want %v", got, want)
+	}
+	s.RemoveAt(1).Map(func(v, _ int) int { return v * 10 })
+	if got, want := s.Values(), []int{10, 40, 50}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RemoveAt().Map() = %v, want %v", got, want)
+	}
+	if i := s.FindLastIndex(func(v int) bool { return v > 10 }); i != 2 {
+		t.Errorf("FindLastIndex() = %d, want 2", i)
+	}
+	if got := s.Join(","); got != "10,40,50" {
+		t.Errorf("Join() = %v", got)
+	}
+}
+
+func BenchmarkDequeUnshift(b *testing.B) {
+	d := slice.NewDeque[int]()
+	for i := 0; i < b.N; i++ {
+		d.Unshift(i)
+	}
+}
+
+func BenchmarkAdvancedSliceUnshift(b *testing.B) {
+	s := slice.NewAdvancedSlice[int]()
+	for i := 0; i < b.N; i++ {
+		s.Unshift(i)
+	}
+}
+
+func BenchmarkDequePushShift(b *testing.B) {
+	d := slice.NewDeque[int]()
+	for i := 0; i < b.N; i++ {
+		d.Push(i)
+		if i%2 == 1 {
+			d.Shift()
+		}
+	}
+}
+
+func BenchmarkAdvancedSlicePushShift(b *testing.B) {
+	s := slice.NewAdvancedSlice[int]()
+	for i := 0; i < b.N; i++ {
+		s.Push(i)
+		if i%2 == 1 {
+			s.Shift()
+		}
+	}
+}
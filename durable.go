@@ -0,0 +1,601 @@
+package slice
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+const (
+	// durableSnapshotFile holds the compacted contents of a DurableSlice.
+	durableSnapshotFile = "snapshot.json"
+	// durableLogFile holds the mutations made since the snapshot, one JSON record per line.
+	durableLogFile = "log.jsonl"
+)
+
+// Log operations. Mutations that have no compact record are logged as durableReset with the full contents.
+const (
+	durablePush     = "push"
+	durableUnshift  = "unshift"
+	durablePop      = "pop"
+	durableShift    = "shift"
+	durableRemoveAt = "remove_at"
+	durableReverse  = "reverse"
+	durableReset    = "reset"
+)
+
+// SyncPolicy decides when a DurableSlice flushes its log to stable storage.
+type SyncPolicy int
+
+const (
+	// SyncAlways calls fsync after every logged mutation. A mutation is durable once its call returns.
+	SyncAlways SyncPolicy = iota
+	// SyncNone leaves flushing to the operating system, or to explicit Sync and Close calls.
+	// Mutations made since the last flush may be lost if the machine crashes.
+	SyncNone
+)
+
+// DurableOption configures OpenDurableSlice.
+type DurableOption func(*durableOptions)
+
+// durableOptions holds the settings collected from DurableOption values.
+type durableOptions struct {
+	sync         SyncPolicy
+	compactEvery int
+}
+
+// WithSyncPolicy sets when the log is flushed to stable storage. The default is SyncAlways.
+//
+// Parameters:
+//   - p: The sync policy.
+//
+// Returns:
+//
+//   - DurableOption: The option.
+func WithSyncPolicy(p SyncPolicy) DurableOption {
+	return func(o *durableOptions) {
+		o.sync = p
+	}
+}
+
+// WithCompactEvery compacts the log into a snapshot after every n logged mutations. The default is 1000;
+// 0 disables automatic compaction.
+//
+// Parameters:
+//   - n: The number of mutations between compactions.
+//
+// Returns:
+//
+//   - DurableOption: The option.
+func WithCompactEvery(n int) DurableOption {
+	return func(o *durableOptions) {
+		o.compactEvery = max(n, 0)
+	}
+}
+
+// durableRecord is one line of the log. Seq increases by one per record and survives compaction,
+// so records already folded into a snapshot are recognised and skipped on replay.
+type durableRecord struct {
+	Seq    uint64          `json:"seq"`
+	Op     string          `json:"op"`
+	Index  int             `json:"index,omitempty"`
+	Values json.RawMessage `json:"values,omitempty"`
+}
+
+// durableLog is the open log file.
+type durableLog interface {
+	io.Writer
+	Sync() error
+	Truncate(size int64) error
+	Close() error
+}
+
+// durableSnapshot is the content of the snapshot file.
+type durableSnapshot[T any] struct {
+	Seq    uint64 `json:"seq"`
+	Values []T    `json:"values"`
+}
+
+var _ IAdvancedSlice[any] = (*DurableSlice[any])(nil)
+
+// DurableSlice is an advanced slice persisted in a directory. Every mutation is appended to a log
+// as JSON, the same encoding String uses, and the log is periodically compacted into a snapshot
+// that is replaced atomically. Opening the directory again replays the snapshot and the log.
+//
+// Mutating methods cannot return errors, so a failure to write the log is recorded and reported
+// by Err; the in-memory change is kept. From then on the log is out of step with the elements, so
+// nothing more is appended to it: each later mutation instead tries to compact the full contents into
+// a snapshot, and logging resumes once that succeeds. The element type must round-trip through encoding/json.
+type DurableSlice[T any] struct {
+	*advancedSlice[T]
+	dir     string
+	opts    durableOptions
+	log     durableLog
+	seq     uint64
+	pending int
+	// broken is the failure that left the log out of step with the elements, until a Compact succeeds.
+	broken error
+}
+
+// OpenDurableSlice opens the durable slice stored in dir, creating the directory if needed.
+// A record torn by a crash at the end of the log is discarded and the log truncated to the last
+// complete record.
+//
+// Parameters:
+//   - dir: The directory holding the snapshot and log files.
+//   - opts: Options for syncing and compaction.
+//
+// Returns:
+//
+//   - *DurableSlice[T]: The durable slice, holding the recovered elements.
+//   - error: An error if the files cannot be read, or one wrapping ErrCorruptLog if a record
+//     before the end of the log is damaged.
+//
+// Example:
+//
+//	queue, err := OpenDurableSlice[Job]("/var/lib/app/queue", WithCompactEvery(500))
+//	if err != nil {
+//		return err
+//	}
+//	defer queue.Close()
+//	queue.Push(job)
+//	if err := queue.Err(); err != nil {
+//		log.Printf("job not persisted: %v", err)
+//	}
+func OpenDurableSlice[T any](dir string, opts ...DurableOption) (*DurableSlice[T], error) {
+	o := durableOptions{sync: SyncAlways, compactEvery: 1000}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	s := &DurableSlice[T]{advancedSlice: &advancedSlice[T]{}, dir: dir, opts: o}
+	if err := s.loadSnapshot(); err != nil {
+		return nil, err
+	}
+	if err := s.replay(); err != nil {
+		return nil, err
+	}
+	log, err := os.OpenFile(filepath.Join(dir, durableLogFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	s.log = log
+	return s, nil
+}
+
+// loadSnapshot reads the snapshot file, if there is one.
+func (s *DurableSlice[T]) loadSnapshot() error {
+	b, err := os.ReadFile(filepath.Join(s.dir, durableSnapshotFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var snap durableSnapshot[T]
+	if err := json.Unmarshal(b, &snap); err != nil {
+		return fmt.Errorf("%w: snapshot: %v", ErrCorruptLog, err)
+	}
+	s.data, s.seq = snap.Values, snap.Seq
+	return nil
+}
+
+// replay applies the log records newer than the snapshot and truncates a torn final record.
+func (s *DurableSlice[T]) replay() error {
+	path := filepath.Join(s.dir, durableLogFile)
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	var good int64
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			// An unterminated final line is a torn write.
+			break
+		}
+		if err != nil {
+			return err
+		}
+		var rec durableRecord
+		if jsonErr := json.Unmarshal(line, &rec); jsonErr != nil || rec.Seq == 0 {
+			if _, err := r.Peek(1); err == io.EOF {
+				break // a damaged final record is a torn write too
+			}
+			return fmt.Errorf("%w: record at offset %d: %v", ErrCorruptLog, good, jsonErr)
+		}
+		if rec.Seq > s.seq+1 {
+			return fmt.Errorf("%w: record %d follows record %d", ErrCorruptLog, rec.Seq, s.seq)
+		}
+		if rec.Seq > s.seq {
+			if err := s.apply(rec); err != nil {
+				return fmt.Errorf("%w: record %d: %v", ErrCorruptLog, rec.Seq, err)
+			}
+			s.seq = rec.Seq
+			s.pending++
+		}
+		good += int64(len(line))
+	}
+	if info, err := f.Stat(); err == nil && info.Size() > good {
+		return os.Truncate(path, good)
+	}
+	return nil
+}
+
+// apply performs a logged mutation on the in-memory elements.
+func (s *DurableSlice[T]) apply(rec durableRecord) error {
+	var values []T
+	if len(rec.Values) > 0 {
+		if err := json.Unmarshal(rec.Values, &values); err != nil {
+			return err
+		}
+	}
+	switch rec.Op {
+	case durablePush:
+		s.data = append(s.data, values...)
+	case durableUnshift:
+		s.data = append(values, s.data...)
+	case durablePop, durableShift, durableRemoveAt:
+		i := rec.Index
+		switch rec.Op {
+		case durablePop:
+			i = len(s.data) - 1
+		case durableShift:
+			i = 0
+		}
+		if i < 0 || i >= len(s.data) {
+			return indexError(i, len(s.data))
+		}
+		s.data = slices.Delete(s.data, i, i+1)
+	case durableReverse:
+		Reverse(s.data)
+	case durableReset:
+		s.data = values
+	default:
+		return fmt.Errorf("unknown operation %q", rec.Op)
+	}
+	return nil
+}
+
+// write appends a record to the log, syncing and compacting according to the options.
+// Failures are recorded for Err. While the log is out of step after a failure, it compacts instead;
+// the snapshot already holds the change being logged.
+func (s *DurableSlice[T]) write(op string, index int, values []T) {
+	if s.broken != nil {
+		if err := s.Compact(); err != nil {
+			s.err = err
+		}
+		return
+	}
+	rec := durableRecord{Seq: s.seq + 1, Op: op, Index: index}
+	if op == durablePush || op == durableUnshift || op == durableReset {
+		b, err := json.Marshal(values)
+		if err != nil {
+			s.fail(err)
+			return
+		}
+		rec.Values = b
+	}
+	line, err := json.Marshal(rec)
+	if err != nil {
+		s.fail(err)
+		return
+	}
+	if _, err := s.log.Write(append(line, '\n')); err != nil {
+		s.fail(err)
+		return
+	}
+	// The record may reach the disk even if the sync below fails, so the next snapshot
+	// must cover it or replay would apply it a second time.
+	s.seq = rec.Seq
+	s.pending++
+	if s.opts.sync == SyncAlways {
+		if err := s.log.Sync(); err != nil {
+			s.fail(err)
+			return
+		}
+	}
+	if s.opts.compactEvery > 0 && s.pending >= s.opts.compactEvery {
+		if err := s.Compact(); err != nil {
+			s.err = err
+		}
+	}
+}
+
+// fail records a failure to log a change that has already been made in memory.
+func (s *DurableSlice[T]) fail(err error) {
+	s.err, s.broken = err, err
+}
+
+// reset logs the current contents in full.
+func (s *DurableSlice[T]) reset() {
+	s.write(durableReset, 0, s.data)
+}
+
+// Compact writes the current contents to a new snapshot, replaces the old one atomically and empties the log.
+// After a failed log write, a successful Compact brings the files back in step with the elements.
+//
+// Returns:
+//
+//   - error: An error if the snapshot cannot be written; the log is left intact in that case.
+func (s *DurableSlice[T]) Compact() error {
+	b, err := json.Marshal(durableSnapshot[T]{Seq: s.seq, Values: append([]T{}, s.data...)})
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, durableSnapshotFile+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(s.dir, durableSnapshotFile)); err != nil {
+		return err
+	}
+	if err := syncDir(s.dir); err != nil {
+		return err
+	}
+	// A crash before the truncation is harmless: replay skips records already in the snapshot.
+	if err := s.log.Truncate(0); err != nil {
+		return err
+	}
+	if err := s.log.Sync(); err != nil {
+		return err
+	}
+	s.pending, s.broken = 0, nil
+	return nil
+}
+
+// syncDir flushes a directory entry change, such as a rename, to stable storage.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// Sync flushes the log to stable storage.
+//
+// Returns:
+//
+//   - error: The error from fsync, if any.
+func (s *DurableSlice[T]) Sync() error {
+	return s.log.Sync()
+}
+
+// Close flushes and closes the log. The slice must not be mutated afterwards.
+//
+// Returns:
+//
+//   - error: The first error from flushing or closing.
+func (s *DurableSlice[T]) Close() error {
+	err := s.log.Sync()
+	if cerr := s.log.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Push appends the values and logs them.
+func (s *DurableSlice[T]) Push(values ...T) IAdvancedSlice[T] {
+	if len(values) == 0 {
+		return s
+	}
+	s.advancedSlice.Push(values...)
+	s.write(durablePush, 0, values)
+	return s
+}
+
+// PushSlice appends the elements of the given slices and logs them.
+func (s *DurableSlice[T]) PushSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	return s.Push(Concat(Map(values, func(v IAdvancedSlice[T], _ int) []T { return v.Values() })...)...)
+}
+
+// Concat appends the elements of the given slices and logs them.
+func (s *DurableSlice[T]) Concat(ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	return s.PushSlice(ss...)
+}
+
+// Unshift prepends the values and logs them.
+func (s *DurableSlice[T]) Unshift(values ...T) IAdvancedSlice[T] {
+	if len(values) == 0 {
+		return s
+	}
+	s.data = append(append([]T(nil), values...), s.data...)
+	s.write(durableUnshift, 0, values)
+	return s
+}
+
+// UnshiftSlice prepends the elements of each given slice in turn and logs them.
+func (s *DurableSlice[T]) UnshiftSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	for _, v := range values {
+		s.Unshift(v.Values()...)
+	}
+	return s
+}
+
+// Pop removes and returns the last element, logging the removal.
+func (s *DurableSlice[T]) Pop() T {
+	if len(s.data) == 0 {
+		return s.advancedSlice.Pop()
+	}
+	v, _ := s.PopIs()
+	return v
+}
+
+// PopIs removes and returns the last element, logging the removal.
+func (s *DurableSlice[T]) PopIs() (T, bool) {
+	v, ok := s.advancedSlice.PopIs()
+	if ok {
+		s.write(durablePop, 0, nil)
+	}
+	return v, ok
+}
+
+// PopErr removes and returns the last element, logging the removal.
+func (s *DurableSlice[T]) PopErr() (T, error) {
+	v, err := s.advancedSlice.PopErr()
+	if err == nil {
+		s.write(durablePop, 0, nil)
+	}
+	return v, err
+}
+
+// Shift removes and returns the first element, logging the removal.
+func (s *DurableSlice[T]) Shift() T {
+	if len(s.data) == 0 {
+		return s.advancedSlice.Shift()
+	}
+	v, _ := s.ShiftIs()
+	return v
+}
+
+// ShiftIs removes and returns the first element, logging the removal.
+func (s *DurableSlice[T]) ShiftIs() (T, bool) {
+	v, ok := s.advancedSlice.ShiftIs()
+	if ok {
+		s.write(durableShift, 0, nil)
+	}
+	return v, ok
+}
+
+// ShiftErr removes and returns the first element, logging the removal.
+func (s *DurableSlice[T]) ShiftErr() (T, error) {
+	v, err := s.advancedSlice.ShiftErr()
+	if err == nil {
+		s.write(durableShift, 0, nil)
+	}
+	return v, err
+}
+
+// RemoveAt removes the element at index and logs the removal.
+func (s *DurableSlice[T]) RemoveAt(index int) IAdvancedSlice[T] {
+	if i, ok := s.resolve(index); ok {
+		s.data = RemoveAt(s.data, i)
+		s.write(durableRemoveAt, i, nil)
+	}
+	return s
+}
+
+// RemoveAtErr removes the element at index and logs the removal, or returns an error as RemoveAtErr does.
+func (s *DurableSlice[T]) RemoveAtErr(index int) (IAdvancedSlice[T], error) {
+	i, err := resolveIndexErr(len(s.data), index, s.bounds)
+	if err != nil {
+		return s, err
+	}
+	s.data = RemoveAt(s.data, i)
+	s.write(durableRemoveAt, i, nil)
+	return s, nil
+}
+
+// Reverse reverses the order of the elements and logs the step.
+func (s *DurableSlice[T]) Reverse() IAdvancedSlice[T] {
+	s.advancedSlice.Reverse()
+	s.write(durableReverse, 0, nil)
+	return s
+}
+
+// Map replaces each element with the result of f and logs the new contents.
+func (s *DurableSlice[T]) Map(f func(T, int) T) IAdvancedSlice[T] {
+	s.advancedSlice.Map(f)
+	s.reset()
+	return s
+}
+
+// Unique keeps the first occurrence of each key returned by f and logs the new contents.
+func (s *DurableSlice[T]) Unique(f func(T) string) IAdvancedSlice[T] {
+	s.advancedSlice.Unique(f)
+	s.reset()
+	return s
+}
+
+// CopyWithIn keeps only the elements at the given indices and logs the new contents.
+func (s *DurableSlice[T]) CopyWithIn(indexes ...int) IAdvancedSlice[T] {
+	s.advancedSlice.CopyWithIn(indexes...)
+	s.reset()
+	return s
+}
+
+// Slice keeps only the selected subset and logs the new contents.
+func (s *DurableSlice[T]) Slice(index ...int) IAdvancedSlice[T] {
+	s.advancedSlice.Slice(index...)
+	s.reset()
+	return s
+}
+
+// SliceErr keeps only the selected subset and logs the new contents, or returns an error as SliceErr does.
+func (s *DurableSlice[T]) SliceErr(index ...int) (IAdvancedSlice[T], error) {
+	_, err := s.advancedSlice.SliceErr(index...)
+	if err == nil {
+		s.reset()
+	}
+	return s, err
+}
+
+// Fill sets the selected elements to value and logs the new contents.
+func (s *DurableSlice[T]) Fill(value T, index ...int) IAdvancedSlice[T] {
+	s.advancedSlice.Fill(value, index...)
+	s.reset()
+	return s
+}
+
+// Sort sorts the elements using f and logs the new contents.
+func (s *DurableSlice[T]) Sort(f func(T, T) bool) IAdvancedSlice[T] {
+	s.advancedSlice.Sort(f)
+	s.reset()
+	return s
+}
+
+// Shuffle randomly permutes the elements and logs the new contents.
+func (s *DurableSlice[T]) Shuffle(r *rand.Rand) IAdvancedSlice[T] {
+	s.advancedSlice.Shuffle(r)
+	s.reset()
+	return s
+}
+
+// Remove removes the elements that satisfy f and logs the new contents.
+func (s *DurableSlice[T]) Remove(f func(T, int) bool) IAdvancedSlice[T] {
+	n := len(s.data)
+	s.advancedSlice.Remove(f)
+	if len(s.data) != n {
+		s.reset()
+	}
+	return s
+}
+
+// Begin starts a transaction over the slice; its commit is logged as a single step.
+func (s *DurableSlice[T]) Begin(validators ...Validator[T]) *Tx[T] {
+	return Begin[T](s, validators...)
+}
+
+// replace swaps in new contents and logs them.
+func (s *DurableSlice[T]) replace(values []T) {
+	s.data = values
+	s.reset()
+}
@@ -0,0 +1,282 @@
+package slice_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+// openDurable opens a durable slice of ints in dir, failing the test on error.
+func openDurable(t *testing.T, dir string, opts ...slice.DurableOption) *slice.DurableSlice[int] {
+	t.Helper()
+	s, err := slice.OpenDurableSlice[int](dir, opts...)
+	if err != nil {
+		t.Fatalf("OpenDurableSlice() error = %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestDurableSliceReplay(t *testing.T) {
+	dir := t.TempDir()
+	s := openDurable(t, dir)
+	s.Push(5, 3, 8, 1)
+	s.Unshift(9)
+	s.Pop()
+	s.Shift()
+	s.RemoveAt(1)
+	s.Push(7, 7)
+	s.Sort(func(a, b int) bool { return a < b })
+	s.Reverse()
+	s.Unique(func(v int) string { return string(rune('0' + v)) })
+	s.Map(func(v, _ int) int { return v * 10 })
+	if err := s.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+	want := []int{80, 70, 50}
+	if got := s.Values(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Values() = %v, want %v", got, want)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	reopened := openDurable(t, dir)
+	if got := reopened.Values(); !reflect.DeepEqual(got, want) {
+		t.Errorf("after reopen = %v, want %v", got, want)
+	}
+}
+
+func TestDurableSliceCompaction(t *testing.T) {
+	dir := t.TempDir()
+	s := openDurable(t, dir, slice.WithCompactEvery(3), slice.WithSyncPolicy(slice.SyncNone))
+	for i := range 10 {
+		s.Push(i)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "snapshot.json")); err != nil {
+		t.Fatalf("snapshot not written: %v", err)
+	}
+	if err := s.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	reopened := openDurable(t, dir)
+	if got, want := reopened.Values(), []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after reopen = %v, want %v", got, want)
+	}
+}
+
+func TestDurableSliceTornLog(t *testing.T) {
+	dir := t.TempDir()
+	s := openDurable(t, dir, slice.WithCompactEvery(0))
+	s.Push(1, 2)
+	s.Push(3)
+	log := filepath.Join(dir, "log.jsonl")
+	before, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Push(4)
+	s.Close()
+	full, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a crash part-way through writing the last record, at every possible length.
+	for cut := len(before); cut < len(full); cut++ {
+		if err := os.WriteFile(log, full[:cut], 0o644); err != nil {
+			t.Fatal(err)
+		}
+		recovered, err := slice.OpenDurableSlice[int](dir)
+		if err != nil {
+			t.Fatalf("cut %d: OpenDurableSlice() error = %v", cut, err)
+		}
+		if got := recovered.Values(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+			t.Errorf("cut %d: recovered %v, want [1 2 3]", cut, got)
+		}
+		recovered.Push(5)
+		recovered.Close()
+		again, err := slice.OpenDurableSlice[int](dir)
+		if err != nil {
+			t.Fatalf("cut %d: reopen error = %v", cut, err)
+		}
+		if got := again.Values(); !reflect.DeepEqual(got, []int{1, 2, 3, 5}) {
+			t.Errorf("cut %d: after writing past the truncation = %v, want [1 2 3 5]", cut, got)
+		}
+		again.Close()
+	}
+}
+
+func TestDurableSliceCorruptLog(t *testing.T) {
+	dir := t.TempDir()
+	s := openDurable(t, dir, slice.WithCompactEvery(0))
+	s.Push(1)
+	s.Push(2)
+	s.Close()
+	log := filepath.Join(dir, "log.jsonl")
+	b, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[0] = '!' // damage the first of two records
+	if err := os.WriteFile(log, b, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := slice.OpenDurableSlice[int](dir); !errors.Is(err, slice.ErrCorruptLog) {
+		t.Errorf("OpenDurableSlice() error = %v, want ErrCorruptLog", err)
+	}
+}
+
+func TestDurableSliceCrashDuringCompaction(t *testing.T) {
+	dir := t.TempDir()
+	s := openDurable(t, dir, slice.WithCompactEvery(0))
+	s.Push(1, 2)
+	s.Shift()
+	log := filepath.Join(dir, "log.jsonl")
+	stale, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Compact(); err != nil {
+		t.Fatalf("Compact() error = %v", err)
+	}
+	s.Push(3)
+	s.Close()
+
+	// Pretend the log was never truncated after the snapshot was renamed into place.
+	full, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(log, append(stale, full...), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	reopened := openDurable(t, dir)
+	if got := reopened.Values(); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("after reopen = %v, want [2 3]", got)
+	}
+}
+
+func TestDurableSliceWriteError(t *testing.T) {
+	s, err := slice.OpenDurableSlice[int](t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+	s.Push(1) // the log is closed, so the write fails
+	if err := s.Err(); err == nil {
+		t.Error("Err() = nil after a failed write")
+	}
+	if got := s.Values(); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("Values() = %v, want the in-memory change kept", got)
+	}
+}
+
+// flakyLog fails every write while failing is set, every sync while failingSync is set,
+// and every truncation while failingTruncate is set.
+type flakyLog struct {
+	slice.DurableLog
+	failing         bool
+	failingSync     bool
+	failingTruncate bool
+}
+
+func (l *flakyLog) Write(p []byte) (int, error) {
+	if l.failing {
+		return 0, errDiskFull
+	}
+	return l.DurableLog.Write(p)
+}
+
+func (l *flakyLog) Sync() error {
+	if l.failingSync {
+		return errDiskFull
+	}
+	return l.DurableLog.Sync()
+}
+
+func (l *flakyLog) Truncate(size int64) error {
+	if l.failingTruncate {
+		return errDiskFull
+	}
+	return l.DurableLog.Truncate(size)
+}
+
+var errDiskFull = errors.New("disk full")
+
+func TestDurableSliceRecoversFromFailedWrite(t *testing.T) {
+	dir := t.TempDir()
+	s := openDurable(t, dir, slice.WithCompactEvery(0))
+	log := &flakyLog{}
+	slice.WrapDurableLog(s, func(l slice.DurableLog) slice.DurableLog {
+		log.DurableLog = l
+		return log
+	})
+	s.Push(1)
+	log.failing = true
+	s.Push(2)
+	if err := s.Err(); !errors.Is(err, errDiskFull) {
+		t.Fatalf("Err() = %v, want %v", err, errDiskFull)
+	}
+	s.Push(3) // writes a snapshot instead of appending to the failing log
+	if err := s.Err(); err != nil {
+		t.Fatalf("Err() = %v after compacting a full snapshot", err)
+	}
+	log.failing = false
+	s.Push(4)
+	s.RemoveAt(0)
+	if err := s.Err(); err != nil {
+		t.Fatalf("Err() = %v after the log recovered", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := openDurable(t, dir).Values(), []int{2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after reopen = %v, want %v", got, want)
+	}
+}
+
+func TestDurableSliceFailedSyncIsNotReplayedTwice(t *testing.T) {
+	dir := t.TempDir()
+	s := openDurable(t, dir, slice.WithCompactEvery(0))
+	log := &flakyLog{}
+	slice.WrapDurableLog(s, func(l slice.DurableLog) slice.DurableLog {
+		log.DurableLog = l
+		return log
+	})
+	s.Push(1)
+	log.failingSync = true
+	s.Push(2) // the record is written but not synced
+	if err := s.Err(); !errors.Is(err, errDiskFull) {
+		t.Fatalf("Err() = %v, want %v", err, errDiskFull)
+	}
+	log.failingSync = false
+	log.failingTruncate = true
+	s.Push(3) // compacts, then crashes before the log is truncated
+	if err := s.Err(); !errors.Is(err, errDiskFull) {
+		t.Fatalf("Err() = %v, want %v", err, errDiskFull)
+	}
+	if got, want := openDurable(t, dir).Values(), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after reopen = %v, want %v", got, want)
+	}
+}
+
+func TestDurableSliceTransaction(t *testing.T) {
+	dir := t.TempDir()
+	s := openDurable(t, dir)
+	s.Push(1, 2, 3)
+	tx := s.Begin()
+	tx.RemoveAt(0).Push(4)
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+	reopened := openDurable(t, dir)
+	if got := reopened.Values(); !reflect.DeepEqual(got, []int{2, 3, 4}) {
+		t.Errorf("after reopen = %v, want [2 3 4]", got)
+	}
+}
@@ -0,0 +1,43 @@
+package slice
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrDuplicateKey is returned when two elements produce the same key and the ConflictError policy is in effect.
+	ErrDuplicateKey = errors.New("slice: duplicate key")
+	// ErrIndexOutOfRange is returned when an index does not refer to an element of the slice.
+	ErrIndexOutOfRange = errors.New("slice: index out of range")
+	// ErrEmpty is returned when an element is requested from an empty slice.
+	ErrEmpty = errors.New("slice: empty slice")
+	// ErrInvalidStep is returned when a slicing step is not usable, such as zero.
+	ErrInvalidStep = errors.New("slice: invalid step")
+	// ErrLengthMismatch is returned when an element-wise operation is given slices of different lengths.
+	ErrLengthMismatch = errors.New("slice: length mismatch")
+	// ErrDivisionByZero is returned when an element-wise division meets a zero divisor.
+	ErrDivisionByZero = errors.New("slice: division by zero")
+	// ErrRaggedGrid is returned when a grid operation needs every row to have the same length.
+	ErrRaggedGrid = errors.New("slice: ragged grid")
+	// ErrInvalidShape is returned when requested grid dimensions do not fit the data.
+	ErrInvalidShape = errors.New("slice: invalid shape")
+	// ErrInvalidBatchSize is returned when a batch size is not positive.
+	ErrInvalidBatchSize = errors.New("slice: invalid batch size")
+	// ErrNoCheckpoint is returned when a history checkpoint is unknown or no longer reachable.
+	ErrNoCheckpoint = errors.New("slice: no such checkpoint")
+	// ErrTxDone is returned when a transaction is used after it has been committed or rolled back.
+	ErrTxDone = errors.New("slice: transaction already committed or rolled back")
+	// ErrCorruptLog is returned when a durable slice's snapshot or log cannot be replayed.
+	ErrCorruptLog = errors.New("slice: corrupt log")
+)
+
+// indexError returns an error wrapping ErrIndexOutOfRange that describes the bad index.
+func indexError(index, n int) error {
+	return fmt.Errorf("%w: index %d, length %d", ErrIndexOutOfRange, index, n)
+}
+
+// lengthError returns an error wrapping ErrLengthMismatch that describes both lengths.
+func lengthError(a, b int) error {
+	return fmt.Errorf("%w: %d and %d", ErrLengthMismatch, a, b)
+}
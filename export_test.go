@@ -0,0 +1,9 @@
+package slice
+
+// DurableLog is the log file of a DurableSlice, exposed so that tests can make writes to it fail.
+type DurableLog = durableLog
+
+// WrapDurableLog replaces the log of s with the result of wrap.
+func WrapDurableLog[T any](s *DurableSlice[T], wrap func(DurableLog) DurableLog) {
+	s.log = wrap(s.log)
+}
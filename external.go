@@ -0,0 +1,371 @@
+package slice
+
+import (
+	"bufio"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+	"os"
+	"sort"
+)
+
+// Encoder writes records of type T to an underlying stream.
+type Encoder[T any] interface {
+	Encode(v T) error
+}
+
+// Decoder reads records of type T from an underlying stream. Decode returns io.EOF once the
+// stream ends cleanly.
+type Decoder[T any] interface {
+	Decode() (T, error)
+}
+
+// Codec creates the encoders and decoders ExternalSort uses to spill runs to disk and, in
+// ExternalSortTo, to read its input and write its output.
+type Codec[T any] interface {
+	NewEncoder(w io.Writer) Encoder[T]
+	NewDecoder(r io.Reader) Decoder[T]
+}
+
+// JSONCodec returns a codec that stores records as a stream of JSON values, one per line.
+func JSONCodec[T any]() Codec[T] {
+	return jsonCodec[T]{}
+}
+
+// GobCodec returns a codec that stores records as a gob stream. It is usually faster and
+// smaller than JSONCodec for structured records.
+func GobCodec[T any]() Codec[T] {
+	return gobCodec[T]{}
+}
+
+type jsonCodec[T any] struct{}
+
+func (jsonCodec[T]) NewEncoder(w io.Writer) Encoder[T] {
+	return jsonEncoder[T]{json.NewEncoder(w)}
+}
+
+func (jsonCodec[T]) NewDecoder(r io.Reader) Decoder[T] {
+	return jsonDecoder[T]{json.NewDecoder(r)}
+}
+
+type jsonEncoder[T any] struct{ enc *json.Encoder }
+
+func (e jsonEncoder[T]) Encode(v T) error { return e.enc.Encode(v) }
+
+type jsonDecoder[T any] struct{ dec *json.Decoder }
+
+func (d jsonDecoder[T]) Decode() (T, error) {
+	var v T
+	err := d.dec.Decode(&v)
+	return v, err
+}
+
+type gobCodec[T any] struct{}
+
+func (gobCodec[T]) NewEncoder(w io.Writer) Encoder[T] {
+	return gobEncoder[T]{gob.NewEncoder(w)}
+}
+
+func (gobCodec[T]) NewDecoder(r io.Reader) Decoder[T] {
+	return gobDecoder[T]{gob.NewDecoder(r)}
+}
+
+type gobEncoder[T any] struct{ enc *gob.Encoder }
+
+func (e gobEncoder[T]) Encode(v T) error { return e.enc.Encode(v) }
+
+type gobDecoder[T any] struct{ dec *gob.Decoder }
+
+func (d gobDecoder[T]) Decode() (T, error) {
+	var v T
+	err := d.dec.Decode(&v)
+	return v, err
+}
+
+// ExternalSortOption configures ExternalSort and ExternalSortTo.
+type ExternalSortOption func(*externalSortOptions)
+
+type externalSortOptions struct {
+	budget int
+	fanIn  int
+	dir    string
+}
+
+// WithMemoryBudget sets the maximum number of records held in memory at once, which is also the
+// size of each sorted run spilled to disk. Values below 1 are ignored. The default is 100000.
+func WithMemoryBudget(records int) ExternalSortOption {
+	return func(o *externalSortOptions) {
+		if records > 0 {
+			o.budget = records
+		}
+	}
+}
+
+// WithMaxFanIn sets how many runs are merged, and so how many temp files are open, at once.
+// When there are more runs, they are merged in several passes. Values below 2 are ignored.
+// The default is 64.
+func WithMaxFanIn(n int) ExternalSortOption {
+	return func(o *externalSortOptions) {
+		if n > 1 {
+			o.fanIn = n
+		}
+	}
+}
+
+// WithTempDir sets the directory in which temp files are created. The default is os.TempDir.
+func WithTempDir(dir string) ExternalSortOption {
+	return func(o *externalSortOptions) {
+		o.dir = dir
+	}
+}
+
+// errStopped signals that the consumer of ExternalSort stopped iterating.
+var errStopped = errors.New("slice: iteration stopped")
+
+// ExternalSort sorts a sequence that may not fit in memory.
+// Records are collected into runs of at most the memory budget, each run is sorted and spilled
+// to a temp file using codec, and the runs are k-way merged. The sort is stable.
+// Nothing happens until the result is ranged over; the temp files are removed when the loop
+// ends, whether it completes, breaks early or stops on an error.
+//
+// Parameters:
+//   - in: The records to sort.
+//   - less: Reports whether a sorts before b.
+//   - codec: How runs are stored on disk.
+//   - opts: Options such as WithMemoryBudget.
+//
+// Returns:
+//
+//   - iter.Seq2[T, error]: The sorted records. On failure a single zero value is yielded with the error, and iteration stops.
+//
+// Example:
+//
+//	sorted := ExternalSort(readLogs(), func(a, b Log) bool { return a.Time.Before(b.Time) },
+//		GobCodec[Log](), WithMemoryBudget(1_000_000))
+//	for entry, err := range sorted {
+//		if err != nil {
+//			return err
+//		}
+//		process(entry)
+//	}
+func ExternalSort[T any](in iter.Seq[T], less func(a, b T) bool, codec Codec[T], opts ...ExternalSortOption) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		read := func(add func(T) error) error {
+			for v := range in {
+				if err := add(v); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		emit := func(v T) error {
+			if !yield(v, nil) {
+				return errStopped
+			}
+			return nil
+		}
+		if err := externalSort(read, less, codec, opts, emit); err != nil && !errors.Is(err, errStopped) {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// ExternalSortTo decodes records from r, sorts them as ExternalSort does and encodes the result
+// to w, using codec for all three.
+//
+// Parameters:
+//   - r: The unsorted records.
+//   - w: Where the sorted records are written.
+//   - less: Reports whether a sorts before b.
+//   - codec: How records are read, written and spilled.
+//   - opts: Options such as WithMemoryBudget.
+//
+// Returns:
+//
+//   - error: The first read, write or temp file error. Temp files are removed either way.
+func ExternalSortTo[T any](r io.Reader, w io.Writer, less func(a, b T) bool, codec Codec[T], opts ...ExternalSortOption) error {
+	dec := codec.NewDecoder(bufio.NewReader(r))
+	read := func(add func(T) error) error {
+		for {
+			v, err := dec.Decode()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := add(v); err != nil {
+				return err
+			}
+		}
+	}
+	bw := bufio.NewWriter(w)
+	if err := externalSort(read, less, codec, opts, codec.NewEncoder(bw).Encode); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// externalSort feeds every record from read into sorted runs and merges them into emit.
+func externalSort[T any](read func(add func(T) error) error, less func(a, b T) bool, codec Codec[T], opts []ExternalSortOption, emit func(T) error) error {
+	o := externalSortOptions{budget: 100000, fanIn: 64}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	s := &externalSorter[T]{less: less, codec: codec, opts: o}
+	defer s.cleanup()
+	if err := read(s.add); err != nil {
+		return err
+	}
+	return s.finish(emit)
+}
+
+// externalSorter holds the state of one ExternalSort call.
+type externalSorter[T any] struct {
+	less  func(a, b T) bool
+	codec Codec[T]
+	opts  externalSortOptions
+	dir   string
+	buf   []T
+	runs  []string
+}
+
+// add buffers a record, spilling the buffer once it reaches the memory budget.
+func (s *externalSorter[T]) add(v T) error {
+	s.buf = append(s.buf, v)
+	if len(s.buf) < s.opts.budget {
+		return nil
+	}
+	return s.spill()
+}
+
+// sortBuf stably sorts the buffered records.
+func (s *externalSorter[T]) sortBuf() {
+	sort.SliceStable(s.buf, func(i, j int) bool {
+		return s.less(s.buf[i], s.buf[j])
+	})
+}
+
+// spill sorts the buffered records and writes them out as a new run.
+func (s *externalSorter[T]) spill() error {
+	s.sortBuf()
+	path, err := s.writeRun(func(enc Encoder[T]) error {
+		for _, v := range s.buf {
+			if err := enc.Encode(v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	clear(s.buf)
+	s.buf = s.buf[:0]
+	s.runs = append(s.runs, path)
+	return nil
+}
+
+// writeRun creates a temp file and fills it using write.
+func (s *externalSorter[T]) writeRun(write func(Encoder[T]) error) (string, error) {
+	if s.dir == "" {
+		dir, err := os.MkdirTemp(s.opts.dir, "slice-sort-*")
+		if err != nil {
+			return "", err
+		}
+		s.dir = dir
+	}
+	f, err := os.CreateTemp(s.dir, "run-*")
+	if err != nil {
+		return "", err
+	}
+	bw := bufio.NewWriter(f)
+	err = write(s.codec.NewEncoder(bw))
+	if err == nil {
+		err = bw.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return f.Name(), err
+}
+
+// finish merges everything added so far into emit. Input that fits in one run never touches disk.
+func (s *externalSorter[T]) finish(emit func(T) error) error {
+	if len(s.runs) == 0 {
+		s.sortBuf()
+		for _, v := range s.buf {
+			if err := emit(v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(s.buf) > 0 {
+		if err := s.spill(); err != nil {
+			return err
+		}
+	}
+	s.buf = nil
+	runs := s.runs
+	for len(runs) > s.opts.fanIn {
+		var next []string
+		for i := 0; i < len(runs); i += s.opts.fanIn {
+			group := runs[i:min(i+s.opts.fanIn, len(runs))]
+			if len(group) == 1 {
+				next = append(next, group[0])
+				continue
+			}
+			path, err := s.writeRun(func(enc Encoder[T]) error {
+				return s.merge(group, enc.Encode)
+			})
+			if err != nil {
+				return err
+			}
+			for _, p := range group {
+				os.Remove(p)
+			}
+			next = append(next, path)
+		}
+		runs = next
+	}
+	return s.merge(runs, emit)
+}
+
+// merge k-way merges the given runs into emit with mergeSorted. Ties go to the earlier run, keeping the sort stable.
+func (s *externalSorter[T]) merge(runs []string, emit func(T) error) error {
+	var err error
+	sources := make([]func() (T, bool), len(runs))
+	for i, path := range runs {
+		f, openErr := os.Open(path)
+		if openErr != nil {
+			return openErr
+		}
+		defer f.Close()
+		dec := s.codec.NewDecoder(bufio.NewReader(f))
+		sources[i] = func() (T, bool) {
+			v, decErr := dec.Decode()
+			if decErr != nil && decErr != io.EOF && err == nil {
+				err = decErr
+			}
+			return v, decErr == nil
+		}
+	}
+	mergeSorted(s.less, false, sources, func(v T) bool {
+		if err == nil {
+			err = emit(v)
+		}
+		return err == nil
+	})
+	return err
+}
+
+// cleanup removes every temp file created by the sort.
+func (s *externalSorter[T]) cleanup() {
+	if s.dir != "" {
+		os.RemoveAll(s.dir)
+	}
+}
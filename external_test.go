@@ -0,0 +1,147 @@
+package slice_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+type record struct {
+	Key, Seq int
+}
+
+// collect drains an ExternalSort result, failing the test on error.
+func collect[T any](t *testing.T, seq func(func(T, error) bool)) []T {
+	t.Helper()
+	var got []T
+	for v, err := range seq {
+		if err != nil {
+			t.Fatalf("ExternalSort() error = %v", err)
+		}
+		got = append(got, v)
+	}
+	return got
+}
+
+// assertEmptyDir fails the test if dir still holds temp files.
+func assertEmptyDir(t *testing.T, dir string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("temp files left behind: %v", entries)
+	}
+}
+
+func TestExternalSort(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	input := make([]int, 5000)
+	for i := range input {
+		input[i] = r.IntN(1000)
+	}
+	want := slices.Sorted(slices.Values(input))
+	less := func(a, b int) bool { return a < b }
+
+	tests := []struct {
+		name  string
+		codec slice.Codec[int]
+		opts  []slice.ExternalSortOption
+	}{
+		{name: "in memory", codec: slice.JSONCodec[int]()},
+		{name: "single merge", codec: slice.JSONCodec[int](), opts: []slice.ExternalSortOption{slice.WithMemoryBudget(700)}},
+		{name: "multi-pass merge", codec: slice.GobCodec[int](), opts: []slice.ExternalSortOption{slice.WithMemoryBudget(100), slice.WithMaxFanIn(3)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			opts := append(tt.opts, slice.WithTempDir(dir))
+			got := collect(t, slice.ExternalSort(slices.Values(input), less, tt.codec, opts...))
+			if !slices.Equal(got, want) {
+				t.Errorf("ExternalSort() returned %d records out of order", len(got))
+			}
+			assertEmptyDir(t, dir)
+		})
+	}
+}
+
+func TestExternalSortStable(t *testing.T) {
+	var input []record
+	for i := range 500 {
+		input = append(input, record{Key: i % 7, Seq: i})
+	}
+	got := collect(t, slice.ExternalSort(slices.Values(input), func(a, b record) bool { return a.Key < b.Key },
+		slice.GobCodec[record](), slice.WithMemoryBudget(33), slice.WithMaxFanIn(4), slice.WithTempDir(t.TempDir())))
+	if len(got) != len(input) {
+		t.Fatalf("got %d records, want %d", len(got), len(input))
+	}
+	for i := 1; i < len(got); i++ {
+		a, b := got[i-1], got[i]
+		if a.Key > b.Key || a.Key == b.Key && a.Seq > b.Seq {
+			t.Fatalf("records %v and %v out of order", a, b)
+		}
+	}
+}
+
+func TestExternalSortBreakCleansUp(t *testing.T) {
+	dir := t.TempDir()
+	input := []int{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	var got []int
+	for v, err := range slice.ExternalSort(slices.Values(input), func(a, b int) bool { return a < b },
+		slice.JSONCodec[int](), slice.WithMemoryBudget(2), slice.WithTempDir(dir)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, v)
+		if len(got) == 3 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", got)
+	}
+	assertEmptyDir(t, dir)
+}
+
+func TestExternalSortTo(t *testing.T) {
+	in := strings.NewReader(`"pear" "apple" "fig" "kiwi" "banana"`)
+	var out bytes.Buffer
+	err := slice.ExternalSortTo(in, &out, func(a, b string) bool { return a < b },
+		slice.JSONCodec[string](), slice.WithMemoryBudget(2), slice.WithTempDir(t.TempDir()))
+	if err != nil {
+		t.Fatalf("ExternalSortTo() error = %v", err)
+	}
+	if want := "\"apple\"\n\"banana\"\n\"fig\"\n\"kiwi\"\n\"pear\"\n"; out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestExternalSortToError(t *testing.T) {
+	dir := t.TempDir()
+	in := strings.NewReader(`3 1 2 4 {`)
+	err := slice.ExternalSortTo(in, io.Discard, func(a, b int) bool { return a < b },
+		slice.JSONCodec[int](), slice.WithMemoryBudget(2), slice.WithTempDir(dir))
+	if err == nil {
+		t.Fatal("ExternalSortTo() error = nil for truncated input")
+	}
+	assertEmptyDir(t, dir)
+
+	errWrite := errors.New("disk full")
+	err = slice.ExternalSortTo(strings.NewReader(`2 1`), failingWriter{errWrite}, func(a, b int) bool { return a < b },
+		slice.JSONCodec[int](), slice.WithTempDir(dir))
+	if !errors.Is(err, errWrite) {
+		t.Errorf("ExternalSortTo() error = %v, want %v", err, errWrite)
+	}
+}
+
+type failingWriter struct{ err error }
+
+func (w failingWriter) Write([]byte) (int, error) { return 0, w.err }
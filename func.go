@@ -82,7 +82,7 @@ func Concat[T any](slices ...[]T) []T {
 //
 // Parameters:
 //   - s: The original slice.
-//   - indexList: A variadic parameter representing the indices of elements to include in the new slice.
+//   - indexList: A variadic parameter representing the indices of elements to include in the new slice. Negative indices count from the end.
 //
 // Returns:
 //
@@ -93,6 +93,9 @@ func CopyWithIn[T any](s []T, indexList ...int) []T {
 	}
 	newData := make([]T, 0, len(indexList))
 	for _, index := range indexList {
+		if index < 0 {
+			index += len(s)
+		}
 		if index >= len(s) || index < 0 {
 			continue
 		}
@@ -227,6 +230,8 @@ func Join[T any](s []T, seps ...string) string {
 
 // Slice is a generic function that returns a sub-slice of a given slice based on specified indexes.
 // It can handle different scenarios such as single index, start and end indexes, and start, end, and step indexes.
+// Negative begin and end indexes count from the end and are resolved like PySlice; the step must be positive.
+// The input is not modified.
 // Parameters:
 //
 //	s: The original slice of any type.
@@ -234,125 +239,58 @@ func Join[T any](s []T, seps ...string) string {
 //
 // Return value:
 //
-//	Returns the sliced sub-slice.
+//	Returns the sliced sub-slice, or nil if begin is past the end, begin is after end, or step is not positive.
 func Slice[T any](s []T, indexes ...int) []T {
-	// Depending on the number of indexes provided, different slicing scenarios are handled.
-	switch len(indexes) {
-	case 0:
-		// If no index is provided, return the original slice.
+	// If no index is provided, return the original slice.
+	if len(indexes) == 0 {
 		return s
-	case 1:
-		// If only one index is provided, it is treated as the starting point of the slice.
-		begin := indexes[0]
-		// If the starting point is negative, reverse the slice and slice from the corresponding position.
-		if begin < 0 {
-			st := Reverse(s)
-			return Slice(st, begin+1)
-		}
-		// If the starting point exceeds the length of the slice, return nil.
-		if begin > len(s) {
-			return nil
-		}
-		// Return the sub-slice from the starting point to the end.
-		return s[begin:]
-	case 2:
-		// If two indexes are provided, they are treated as the starting and ending points of the slice.
-		begin, end := indexes[0], indexes[1]
-		// If the starting point is negative, reverse the slice and slice according to the new starting and ending points.
-		if begin < 0 {
-			st := Reverse(s)
-			return Slice(st, begin+1, end*-1-1)
-		}
-		// If the ending point exceeds the length of the slice, set it to the length of the slice.
-		if end >= len(s) {
-			end = len(s)
-		}
+	}
+	// A begin past the end of the slice, or a step that does not walk forwards, selects nothing.
+	if indexes[0] > len(s) || len(indexes) > 2 && indexes[2] <= 0 {
+		return nil
+	}
+	// Resolve negative and out-of-range indexes the same way PySlice does.
+	begin, end, step, _ := pyIndices(len(s), indexes...)
+	if len(indexes) < 3 {
 		// If the starting point is greater than the ending point, return nil.
 		if begin > end {
 			return nil
 		}
 		// Return the sub-slice from the starting point to the ending point.
 		return s[begin:end]
-	default:
-		// If more than two indexes are provided, the third is treated as the step size for slicing.
-		begin, end, step := indexes[0], indexes[1], indexes[2]
-		// If the starting point is negative, reverse the slice and slice according to the new starting and ending points.
-		if begin < 0 {
-			st := Reverse(s)
-			return Slice(st, -begin-1, -end-1, step)
-		}
-		// If the ending point exceeds the length of the slice, set it to the length of the slice.
-		if end >= len(s) {
-			end = len(s)
-		}
-
-		// If the starting point is greater than the ending point, return nil.
-		if begin >= end {
-			return nil
-		}
-
-		// If the step size is not positive, return nil.
-		if step <= 0 {
-			return nil
-		}
-		// Create a new slice to store the sliced elements.
-		list := make([]T, 0, (end-begin)/step)
-		// Iterate through the original slice at the specified step size and add the elements to the new slice.
-		for i := begin; i < end; i += step {
-			list = append(list, s[i])
-		}
-		// Return the new slice.
-		return list
 	}
+	// If the starting point is not before the ending point, return nil.
+	if begin >= end {
+		return nil
+	}
+	// Create a new slice and add the elements at the specified step size.
+	list := make([]T, 0, pyLength(begin, end, step))
+	for i := begin; i < end; i += step {
+		list = append(list, s[i])
+	}
+	return list
 }
 
 // Fill sets all elements of the slice to a specified value, optionally at specified indices.
+// The indices follow the same rules as PySlice: a begin or end below zero counts from the end of
+// the slice, out-of-range indices are clamped, and an optional third index is a step. The slice is
+// never reordered, and an empty selection leaves it unchanged.
 //
 // Parameters:
 //   - s: The slice to fill.
 //   - value: The value to set.
-//   - indexes: An optional variadic parameter specifying the indices to fill.
+//   - indexes: An optional variadic parameter specifying the begin, end and step indices.
 //
 // Returns:
 //
-//	The modified slice.
+//	The modified slice, which shares storage with s.
+//
+// Example:
+//
+//	Fill([]int{1, 2, 3, 4, 5}, 0, -2)   // [1 2 3 0 0]
+//	Fill([]int{1, 2, 3, 4, 5}, 0, 1, -1) // [1 0 0 0 5]
 func Fill[T any](s []T, value T, indexes ...int) []T {
-	switch len(indexes) {
-	case 0:
-		for i := range s {
-			s[i] = value
-		}
-		return s
-	case 1:
-		index := indexes[0]
-		if index < 0 {
-			return Fill(Reverse(s), value, -index-1)
-		}
-
-		if index >= len(s) {
-			return s
-		}
-
-		for i := index; i < len(s); i++ {
-			s[i] = value
-		}
-		return s
-	default:
-		begin, end := indexes[0], indexes[1]
-		if begin < 0 {
-			return Fill(Reverse(s), value, -begin-1, -end-1)
-		}
-		if end > len(s) {
-			end = len(s)
-		}
-		if begin >= end {
-			return nil
-		}
-		for i := begin; i < end; i++ {
-			s[i] = value
-		}
-		return s
-	}
+	return PyFill(s, value, indexes...)
 }
 
 // String converts the slice to a JSON string representation.
@@ -379,8 +317,11 @@ func String[T any](s []T) string {
 //
 // Returns:
 //
-//	The element at the specified index.
+//	The element at the specified index, counting from the end for a negative index, or the zero value if it is out of range.
 func At[T any](s []T, index int) T {
+	if index < 0 {
+		index += len(s)
+	}
 	if index < 0 || index >= len(s) {
 		var zero T
 		return zero
@@ -447,12 +388,15 @@ func Remove[T any](s []T, f func(T, int) bool) []T {
 //
 // Parameters:
 //   - s: The slice to modify.
-//   - index: The index of the element to remove.
+//   - index: The index of the element to remove. A negative index counts from the end.
 //
 // Returns:
 //
 //	The updated slice.
 func RemoveAt[T any](s []T, index int) []T {
+	if index < 0 {
+		index += len(s)
+	}
 	if index < 0 || index >= len(s) {
 		return s
 	}
@@ -93,6 +93,7 @@ func TestCopyWithIn(t *testing.T) {
 		{"empty slice", []int{}, []int{}, nil},
 		{"valid indices", []int{1, 2, 3, 4, 5}, []int{0, 2, 4}, []int{1, 3, 5}},
 		{"invalid indices", []int{1, 2, 3}, []int{0, 5}, []int{1}},
+		{"negative indices", []int{1, 2, 3}, []int{-1, -3, -4}, []int{3, 1}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -264,63 +265,22 @@ func TestSlice(t *testing.T) {
 		{"begin and end equal length", []int{1, 2, 3, 4, 5}, []int{5, 5}, []int{}},
 		{"begin and end greater than length", []int{1, 2, 3, 4, 5}, []int{6, 7}, nil},
 		{"step greater than length", []int{1, 2, 3, 4, 5}, []int{0, 5, 6}, []int{1}},
-		{"begin less than zero", []int{1, 2, 3, 4, 5}, []int{-1}, []int{5, 4, 3, 2, 1}},
-		{"begin and end less than zero", []int{1, 2, 3, 4, 5}, []int{-1, -5}, []int{5, 4, 3, 2}},
-		{"begin and end less than zero and end gt 0", []int{1, 2, 3, 4, 5}, []int{-1, 5}, nil},
+		{"begin less than zero", []int{1, 2, 3, 4, 5}, []int{-1}, []int{5}},
+		{"begin and end less than zero", []int{1, 2, 3, 4, 5}, []int{-4, -1}, []int{2, 3, 4}},
+		{"negative begin after negative end", []int{1, 2, 3, 4, 5}, []int{-1, -5}, nil},
+		{"negative begin beyond length", []int{1, 2, 3, 4, 5}, []int{-10}, []int{1, 2, 3, 4, 5}},
+		{"negative end beyond length", []int{1, 2, 3, 4, 5}, []int{0, -10}, []int{}},
 		{"begin and end greater than length", []int{1, 2, 3, 4, 5}, []int{6, 7}, nil},
 		{"begin and end equal length", []int{1, 2, 3, 4, 5}, []int{5, 5}, []int{}},
 		{"begin greater than end", []int{1, 2, 3, 4, 5}, []int{5, 4}, nil},
 		{"begin equal end", []int{1, 2, 3, 4, 5}, []int{5, 5, 1}, nil},
-		// -1 5 1
-		{"-1 5 1", []int{1, 2, 3, 4, 5}, []int{-1, 5, 1}, nil},
-		// -1 5 -1
-		{"1 5 -1", []int{1, 2, 3, 4, 5}, []int{-1, 5, -1}, nil},
-		// -1 -5 1
-		{"-1 -5 1", []int{1, 2, 3, 4, 5}, []int{-1, -5, 1}, []int{5, 4, 3, 2}},
-		// -1 -5 -1
+		{"-1 5 1", []int{1, 2, 3, 4, 5}, []int{-1, 5, 1}, []int{5}},
+		{"-5 5 2", []int{1, 2, 3, 4, 5}, []int{-5, 5, 2}, []int{1, 3, 5}},
+		{"-5 -1 2", []int{1, 2, 3, 4, 5}, []int{-5, -1, 2}, []int{1, 3}},
+		{"-10 10 2", []int{1, 2, 3, 4, 5}, []int{-10, 10, 2}, []int{1, 3, 5}},
+		{"-1 -5 1", []int{1, 2, 3, 4, 5}, []int{-1, -5, 1}, nil},
 		{"-1 -5 -1", []int{1, 2, 3, 4, 5}, []int{-1, -5, -1}, nil},
-		// -1 -10 -1
-		{"-1 -10 -1", []int{1, 2, 3, 4, 5}, []int{-1, -10, -1}, nil},
-		// -1 -10 1
-		{"-1 -10 1", []int{1, 2, 3, 4, 5}, []int{-1, -10, 1}, []int{5, 4, 3, 2, 1}},
-		// -1 10 -1
-		{"-1 10 -1", []int{1, 2, 3, 4, 5}, []int{-1, 10, -1}, nil},
-		// -1 10 1
-		{"-1 10 1", []int{1, 2, 3, 4, 5}, []int{-1, 10, 1}, nil},
-		// -1 5 2
-		{"-1 5 2", []int{1, 2, 3, 4, 5}, []int{-1, 5, 2}, nil},
-		// -1 5 -2
-		{"-1 5 -2", []int{1, 2, 3, 4, 5}, []int{-1, 5, -2}, nil},
-		// -1 -5 2
-		{"-1 -5 2", []int{1, 2, 3, 4, 5}, []int{-1, -5, 2}, []int{5, 3}},
-		// -1 -5 -2
-		{"-1 -5 -2", []int{1, 2, 3, 4, 5}, []int{-1, -5, -2}, nil},
-		// -1 -10 -2
-		{"-1 -10 -2", []int{1, 2, 3, 4, 5}, []int{-1, -10, -2}, nil},
-		// -1 -10 2
-		{"-1 -10 2", []int{1, 2, 3, 4, 5}, []int{-1, -10, 2}, []int{5, 3, 1}},
-		// -1 10 -2
-		{"-1 10 -2", []int{1, 2, 3, 4, 5}, []int{-1, 10, -2}, nil},
-		// -1 10 2
-		{"-1 10 2", []int{1, 2, 3, 4, 5}, []int{-1, 10, 2}, nil},
-		// -1 5 5
-		{"-1 5 5", []int{1, 2, 3, 4, 5}, []int{-1, 5, 5}, nil},
-		// -1 5 -5
-		{"-1 5 -5", []int{1, 2, 3, 4, 5}, []int{-1, 5, -5}, nil},
-		// -1 -5 5
-		{"-1 -5 5", []int{1, 2, 3, 4, 5}, []int{-1, -5, 5}, []int{5}},
-		// -1 -5 -5
-		{"-1 -5 -5", []int{1, 2, 3, 4, 5}, []int{-1, -5, -5}, nil},
-		// -1 -10 -5
-		{"-1 -10 -5", []int{1, 2, 3, 4, 5}, []int{-1, -10, -5}, nil},
-		// -1 -10 5
-		{"-1 -10 5", []int{1, 2, 3, 4, 5}, []int{-1, -10, 5}, []int{5}},
-		// -1 10 -5
-		{"-1 10 -5", []int{1, 2, 3, 4, 5}, []int{-1, 10, -5}, nil},
-		// -1 10 5
-		{"-1 10 5", []int{1, 2, 3, 4, 5}, []int{-1, 10, 5}, nil},
-		{"-1 10 10", []int{1, 2, 3, 4, 5}, []int{-1, 10, 10}, nil},
-		{"-1 -10 10", []int{1, 2, 3, 4, 5}, []int{-1, -10, 10}, []int{5}},
+		{"-4 -1 5", []int{1, 2, 3, 4, 5}, []int{-4, -1, 5}, []int{2}},
 	}
 
 	for _, tt := range tests {
@@ -345,13 +305,13 @@ func TestFill(t *testing.T) {
 		{"fill from index", []int{1, 2, 3}, 0, []int{1}, []int{1, 0, 0}},
 		{"fill range", []int{1, 2, 3, 4, 5}, 0, []int{1, 3}, []int{1, 0, 0, 4, 5}},
 		{"fill invalid index", []int{1, 2, 3}, 0, []int{5}, []int{1, 2, 3}},
-		{"fill negative index", []int{1, 2, 3}, 0, []int{-1}, []int{0, 0, 0}},
-		{"fill reverse range", []int{1, 2, 3, 4, 5}, 0, []int{3, 1}, nil},
+		{"fill negative index", []int{1, 2, 3}, 0, []int{-1}, []int{1, 2, 0}},
+		{"fill reverse range", []int{1, 2, 3, 4, 5}, 0, []int{3, 1}, []int{1, 2, 3, 4, 5}},
 		{"fill invalid range", []int{1, 2, 3}, 0, []int{1, 3}, []int{1, 0, 0}},
-		{"fill invalid negative range", []int{1, 2, 3}, 0, []int{-1, 3}, nil},
-		{"fill invalid negative range", []int{1, 2, 3}, 0, []int{1, -3}, nil},
-		{"fill invalid range", []int{1, 2, 3}, 0, []int{3, 1}, nil},
-		{"begin eq end", []int{1, 2, 3}, 0, []int{0, 0}, nil},
+		{"fill negative begin", []int{1, 2, 3}, 0, []int{-1, 3}, []int{1, 2, 0}},
+		{"fill negative end", []int{1, 2, 3}, 0, []int{1, -3}, []int{1, 2, 3}},
+		{"fill invalid range", []int{1, 2, 3}, 0, []int{3, 1}, []int{1, 2, 3}},
+		{"begin eq end", []int{1, 2, 3}, 0, []int{0, 0}, []int{1, 2, 3}},
 		{"end gt len", []int{1, 2, 3}, 0, []int{0, 4}, []int{0, 0, 0}},
 	}
 
@@ -394,7 +354,8 @@ func TestAt(t *testing.T) {
 	}{
 		{"valid index", []int{1, 2, 3}, 1, 2},
 		{"out of bounds", []int{1, 2, 3}, 5, 0},
-		{"negative index", []int{1, 2, 3}, -1, 0},
+		{"negative index", []int{1, 2, 3}, -1, 3},
+		{"negative out of bounds", []int{1, 2, 3}, -4, 0},
 	}
 
 	for _, tt := range tests {
@@ -485,7 +446,8 @@ func TestRemoveAt(t *testing.T) {
 		{"remove first", []int{1, 2, 3, 4}, 0, []int{2, 3, 4}},
 		{"remove last", []int{1, 2, 3, 4}, 3, []int{1, 2, 3}},
 		{"remove out of bounds", []int{1, 2, 3, 4}, 5, []int{1, 2, 3, 4}},
-		{"remove negative index", []int{1, 2, 3, 4}, -1, []int{1, 2, 3, 4}},
+		{"remove negative index", []int{1, 2, 3, 4}, -1, []int{1, 2, 3}},
+		{"remove negative out of bounds", []int{1, 2, 3, 4}, -5, []int{1, 2, 3, 4}},
 	}
 
 	for _, tt := range tests {
@@ -497,3 +459,36 @@ func TestRemoveAt(t *testing.T) {
 		})
 	}
 }
+
+func TestSliceDoesNotModifyInput(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	slice.Slice(s, -2)
+	slice.Slice(s, -1, -3)
+	slice.Slice(s, -1, -5, 2)
+	if want := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(s, want) {
+		t.Errorf("Slice() modified its input: %v, want %v", s, want)
+	}
+}
+
+func TestFillDoesNotModifyInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		indexes []int
+		want    []int
+	}{
+		{"negative begin", []int{-2}, []int{1, 2, 3, 9, 9}},
+		{"negative end", []int{1, -1}, []int{1, 9, 9, 9, 5}},
+		{"empty negative range", []int{-1, -3}, []int{1, 2, 3, 4, 5}},
+		{"begin past end", []int{5}, []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := []int{1, 2, 3, 4, 5}
+			got := slice.Fill(s, 9, tt.indexes...)
+			if !reflect.DeepEqual(s, tt.want) || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Fill(_, 9, %v) left %v and returned %v, want %v", tt.indexes, s, got, tt.want)
+			}
+		})
+	}
+}
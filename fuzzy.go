@@ -0,0 +1,317 @@
+package slice
+
+import (
+	"sort"
+	"strings"
+)
+
+// Similarity scores how alike two strings are, from 0 for nothing in common to 1 for identical.
+type Similarity func(a, b string) float64
+
+// Levenshtein returns the minimum number of single-rune insertions, deletions and substitutions
+// needed to turn a into b.
+//
+// Parameters:
+//   - a: The first string.
+//   - b: The second string.
+//
+// Returns:
+//
+//	The edit distance.
+//
+// Example:
+//
+//	Levenshtein("kitten", "sitting") // 3
+func Levenshtein(a, b string) int {
+	return editDistance([]rune(a), []rune(b), false)
+}
+
+// DamerauLevenshtein returns the edit distance between a and b counting a swap of two adjacent runes
+// as a single edit, in the optimal string alignment form where no substring is edited twice.
+//
+// Parameters:
+//   - a: The first string.
+//   - b: The second string.
+//
+// Returns:
+//
+//	The edit distance.
+//
+// Example:
+//
+//	DamerauLevenshtein("teh", "the") // 1, where Levenshtein gives 2
+func DamerauLevenshtein(a, b string) int {
+	return editDistance([]rune(a), []rune(b), true)
+}
+
+// editDistance computes the Levenshtein distance, or the optimal string alignment distance when
+// transpose is set, keeping only the last three rows of the table.
+func editDistance(a, b []rune, transpose bool) int {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if transpose && i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+// LevenshteinSimilarity turns the Levenshtein distance into a Similarity by dividing it by the
+// length of the longer string.
+func LevenshteinSimilarity(a, b string) float64 {
+	return distanceSimilarity(a, b, false)
+}
+
+// DamerauSimilarity turns the DamerauLevenshtein distance into a Similarity by dividing it by the
+// length of the longer string.
+func DamerauSimilarity(a, b string) float64 {
+	return distanceSimilarity(a, b, true)
+}
+
+// distanceSimilarity normalizes an edit distance to a score between 0 and 1.
+func distanceSimilarity(a, b string, transpose bool) float64 {
+	ra, rb := []rune(a), []rune(b)
+	n := max(len(ra), len(rb))
+	if n == 0 {
+		return 1
+	}
+	return 1 - float64(editDistance(ra, rb, transpose))/float64(n)
+}
+
+// jaroWinklerBoostThreshold is the Jaro score above which JaroWinkler rewards a common prefix.
+const jaroWinklerBoostThreshold = 0.7
+
+// JaroWinkler returns the Jaro-Winkler similarity of a and b. It rewards runes that match in
+// roughly the same positions and gives an extra boost to strings sharing a prefix of up to four runes,
+// which suits short strings such as names. As in Winkler's definition, the boost applies only when
+// the Jaro score is above 0.7, so a shared prefix does not lift otherwise dissimilar strings.
+//
+// Parameters:
+//   - a: The first string.
+//   - b: The second string.
+//
+// Returns:
+//
+//	A score from 0 to 1.
+//
+// Example:
+//
+//	JaroWinkler("MARTHA", "MARHTA") // about 0.961
+func JaroWinkler(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+	window := max(max(len(ra), len(rb))/2-1, 0)
+	matchedA := make([]bool, len(ra))
+	matchedB := make([]bool, len(rb))
+	matches := 0
+	for i, r := range ra {
+		for j := max(0, i-window); j < min(len(rb), i+window+1); j++ {
+			if !matchedB[j] && rb[j] == r {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+	transpositions, j := 0, 0
+	for i, r := range ra {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if r != rb[j] {
+			transpositions++
+		}
+		j++
+	}
+	m := float64(matches)
+	jaro := (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions/2))/m) / 3
+	if jaro <= jaroWinklerBoostThreshold {
+		return jaro
+	}
+	prefix := 0
+	for prefix < min(4, len(ra), len(rb)) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// FuzzyOption configures FuzzyFind, FuzzyGroup and FuzzyUnique.
+type FuzzyOption func(*fuzzyOptions)
+
+type fuzzyOptions struct {
+	similarity Similarity
+	foldCase   bool
+	limit      int
+}
+
+// WithSimilarity sets the similarity measure. The default is JaroWinkler.
+func WithSimilarity(f Similarity) FuzzyOption {
+	return func(o *fuzzyOptions) {
+		if f != nil {
+			o.similarity = f
+		}
+	}
+}
+
+// WithFoldCase makes comparisons ignore case.
+func WithFoldCase() FuzzyOption {
+	return func(o *fuzzyOptions) {
+		o.foldCase = true
+	}
+}
+
+// WithMaxMatches caps the number of matches FuzzyFind returns. Values below 1 mean no limit.
+func WithMaxMatches(n int) FuzzyOption {
+	return func(o *fuzzyOptions) {
+		o.limit = n
+	}
+}
+
+// newFuzzyOptions applies opts over the defaults.
+func newFuzzyOptions(opts []FuzzyOption) fuzzyOptions {
+	o := fuzzyOptions{similarity: JaroWinkler}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// score compares two strings under the options.
+func (o fuzzyOptions) score(a, b string) float64 {
+	if o.foldCase {
+		a, b = strings.ToLower(a), strings.ToLower(b)
+	}
+	return o.similarity(a, b)
+}
+
+// FuzzyMatch is one result of FuzzyFind.
+type FuzzyMatch struct {
+	// Index is the position of the match in the searched slice.
+	Index int
+	// Value is the matching string.
+	Value string
+	// Score is its similarity to the query.
+	Score float64
+}
+
+// FuzzyFind returns the strings in s whose similarity to query is at least threshold,
+// best match first. Equal scores keep the order of s.
+//
+// Parameters:
+//   - s: The strings to search.
+//   - query: The string to look for.
+//   - threshold: The minimum similarity, from 0 to 1.
+//   - opts: Options such as WithSimilarity, WithFoldCase and WithMaxMatches.
+//
+// Returns:
+//
+//	The ranked matches.
+//
+// Example:
+//
+//	FuzzyFind([]string{"Apple iPhone", "Apple iPad", "Pixel"}, "apple iphne", 0.9, WithFoldCase())
+//	// [{0 Apple iPhone 0.98...}]
+func FuzzyFind(s []string, query string, threshold float64, opts ...FuzzyOption) []FuzzyMatch {
+	o := newFuzzyOptions(opts)
+	var matches []FuzzyMatch
+	for i, v := range s {
+		if score := o.score(query, v); score >= threshold {
+			matches = append(matches, FuzzyMatch{Index: i, Value: v, Score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	if o.limit > 0 && len(matches) > o.limit {
+		matches = matches[:o.limit]
+	}
+	return matches
+}
+
+// FuzzyGroup groups the elements of s whose keys are near-duplicates. Each element joins the group
+// whose first element's key is most similar to its own, provided the similarity is at least threshold;
+// otherwise it starts a new group. Groups, and the elements within them, keep the order of s.
+//
+// Parameters:
+//   - s: The slice to group.
+//   - key: A function returning the string to compare for each element.
+//   - threshold: The minimum similarity, from 0 to 1.
+//   - opts: Options such as WithSimilarity and WithFoldCase.
+//
+// Returns:
+//
+//	The groups.
+func FuzzyGroup[T any](s []T, key func(T) string, threshold float64, opts ...FuzzyOption) [][]T {
+	o := newFuzzyOptions(opts)
+	var groups [][]T
+	var keys []string
+	for _, v := range s {
+		k := key(v)
+		best, bestScore := -1, threshold
+		for i, gk := range keys {
+			if score := o.score(k, gk); score >= bestScore && (best < 0 || score > bestScore) {
+				best, bestScore = i, score
+			}
+		}
+		if best < 0 {
+			groups = append(groups, []T{v})
+			keys = append(keys, k)
+			continue
+		}
+		groups[best] = append(groups[best], v)
+	}
+	return groups
+}
+
+// FuzzyUnique keeps the first element of each group of near-duplicates, as grouped by FuzzyGroup.
+// It works like Unique, but compares keys by similarity rather than equality.
+//
+// Parameters:
+//   - s: The slice to deduplicate.
+//   - key: A function returning the string to compare for each element.
+//   - threshold: The minimum similarity for two keys to count as duplicates, from 0 to 1.
+//   - opts: Options such as WithSimilarity and WithFoldCase.
+//
+// Returns:
+//
+//	A new slice without near-duplicates.
+//
+// Example:
+//
+//	FuzzyUnique(products, func(p Product) string { return p.Name }, 0.95, WithFoldCase())
+func FuzzyUnique[T any](s []T, key func(T) string, threshold float64, opts ...FuzzyOption) []T {
+	groups := FuzzyGroup(s, key, threshold, opts...)
+	list := make([]T, len(groups))
+	for i, g := range groups {
+		list[i] = g[0]
+	}
+	return list
+}
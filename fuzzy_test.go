@@ -0,0 +1,118 @@
+package slice_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestEditDistances(t *testing.T) {
+	tests := []struct {
+		a, b         string
+		lev, damerau int
+	}{
+		{"", "", 0, 0},
+		{"", "abc", 3, 3},
+		{"kitten", "sitting", 3, 3},
+		{"flaw", "lawn", 2, 2},
+		{"teh", "the", 2, 1},
+		{"ca", "abc", 3, 3},
+		{"héllo", "hello", 1, 1},
+		{"abcdef", "badcfe", 4, 3},
+	}
+	for _, tt := range tests {
+		if got := slice.Levenshtein(tt.a, tt.b); got != tt.lev {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.lev)
+		}
+		if got := slice.Levenshtein(tt.b, tt.a); got != tt.lev {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.b, tt.a, got, tt.lev)
+		}
+		if got := slice.DamerauLevenshtein(tt.a, tt.b); got != tt.damerau {
+			t.Errorf("DamerauLevenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.damerau)
+		}
+	}
+}
+
+func TestSimilarities(t *testing.T) {
+	tests := []struct {
+		name string
+		f    slice.Similarity
+		a, b string
+		want float64
+	}{
+		{"jaro-winkler identical", slice.JaroWinkler, "same", "same", 1},
+		{"jaro-winkler empty", slice.JaroWinkler, "", "", 1},
+		{"jaro-winkler disjoint", slice.JaroWinkler, "abc", "xyz", 0},
+		{"jaro-winkler martha", slice.JaroWinkler, "MARTHA", "MARHTA", 0.9611},
+		{"jaro-winkler dixon", slice.JaroWinkler, "DIXON", "DICKSONX", 0.8133},
+		{"jaro-winkler low jaro shared prefix", slice.JaroWinkler, "ab", "axxxxxxx", (1.0/2 + 1.0/8 + 1) / 3},
+		{"levenshtein", slice.LevenshteinSimilarity, "kitten", "sitting", 1 - 3.0/7},
+		{"damerau", slice.DamerauSimilarity, "teh", "the", 1 - 1.0/3},
+		{"levenshtein empty", slice.LevenshteinSimilarity, "", "", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f(tt.a, tt.b); math.Abs(got-tt.want) > 1e-4 {
+				t.Errorf("similarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFuzzyFind(t *testing.T) {
+	catalog := []string{"Apple iPhone 15", "Apple iPad Air", "Google Pixel 8", "apple iphone 15", "Apple iPhone 14"}
+	got := slice.FuzzyFind(catalog, "Apple iPhone 15", 0.95)
+	var values []string
+	for _, m := range got {
+		values = append(values, m.Value)
+	}
+	if want := []string{"Apple iPhone 15", "Apple iPhone 14"}; !reflect.DeepEqual(values, want) {
+		t.Errorf("FuzzyFind() = %v, want %v", values, want)
+	}
+	if got[0].Index != 0 || got[0].Score != 1 {
+		t.Errorf("best match = %+v, want index 0 with score 1", got[0])
+	}
+
+	folded := slice.FuzzyFind(catalog, "apple iphone 15", 0.9, slice.WithFoldCase(), slice.WithMaxMatches(2))
+	if len(folded) != 2 || folded[0].Index != 0 || folded[1].Index != 3 {
+		t.Errorf("FuzzyFind(WithFoldCase, WithMaxMatches(2)) = %+v, want indexes 0 and 3", folded)
+	}
+
+	typo := slice.FuzzyFind(catalog, "Gogle Pixle 8", 0.8, slice.WithSimilarity(slice.DamerauSimilarity))
+	if len(typo) != 1 || typo[0].Value != "Google Pixel 8" {
+		t.Errorf("FuzzyFind(DamerauSimilarity) = %+v, want Google Pixel 8", typo)
+	}
+}
+
+func TestFuzzyUnique(t *testing.T) {
+	type product struct {
+		SKU  int
+		Name string
+	}
+	products := []product{
+		{1, "Widget Pro"},
+		{2, "Gadget"},
+		{3, "widget pro"},
+		{4, "Widget Pr0"},
+		{5, "Gadgets"},
+		{6, "Sprocket"},
+	}
+	name := func(p product) string { return p.Name }
+	groups := slice.FuzzyGroup(products, name, 0.9, slice.WithFoldCase())
+	want := [][]product{
+		{{1, "Widget Pro"}, {3, "widget pro"}, {4, "Widget Pr0"}},
+		{{2, "Gadget"}, {5, "Gadgets"}},
+		{{6, "Sprocket"}},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("FuzzyGroup() = %v, want %v", groups, want)
+	}
+	if got, want := slice.FuzzyUnique(products, name, 0.9, slice.WithFoldCase()), []product{{1, "Widget Pro"}, {2, "Gadget"}, {6, "Sprocket"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FuzzyUnique() = %v, want %v", got, want)
+	}
+	if got := slice.FuzzyUnique(products, name, 1); len(got) != len(products) {
+		t.Errorf("FuzzyUnique(threshold 1) = %v, want every distinct name", got)
+	}
+}
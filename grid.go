@@ -0,0 +1,252 @@
+package slice
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Cell is an element of a grid together with its position.
+type Cell[T any] struct {
+	Row   int
+	Col   int
+	Value T
+}
+
+// gridCols returns the common row length of a grid, or an error wrapping ErrRaggedGrid if rows differ.
+func gridCols[T any](g [][]T) (int, error) {
+	if len(g) == 0 {
+		return 0, nil
+	}
+	cols := len(g[0])
+	for i, row := range g[1:] {
+		if len(row) != cols {
+			return 0, fmt.Errorf("%w: row %d has %d columns, row 0 has %d", ErrRaggedGrid, i+1, len(row), cols)
+		}
+	}
+	return cols, nil
+}
+
+// newGrid allocates a rows x cols grid backed by a single array.
+func newGrid[T any](rows, cols int) [][]T {
+	flat := make([]T, rows*cols)
+	g := make([][]T, rows)
+	for i := range g {
+		g[i] = flat[i*cols : (i+1)*cols : (i+1)*cols]
+	}
+	return g
+}
+
+// Transpose swaps the rows and columns of a grid.
+//
+// Parameters:
+//   - g: The grid, with rows of equal length.
+//
+// Returns:
+//
+//	A new grid where result[j][i] == g[i][j], and an error wrapping ErrRaggedGrid if the rows differ in length.
+func Transpose[T any](g [][]T) ([][]T, error) {
+	cols, err := gridCols(g)
+	if err != nil {
+		return nil, err
+	}
+	t := newGrid[T](cols, len(g))
+	for i, row := range g {
+		for j, v := range row {
+			t[j][i] = v
+		}
+	}
+	return t, nil
+}
+
+// Rotate90 rotates a grid a quarter turn clockwise.
+//
+// Parameters:
+//   - g: The grid, with rows of equal length.
+//
+// Returns:
+//
+//	A new grid rotated clockwise, and an error wrapping ErrRaggedGrid if the rows differ in length.
+func Rotate90[T any](g [][]T) ([][]T, error) {
+	cols, err := gridCols(g)
+	if err != nil {
+		return nil, err
+	}
+	rows := len(g)
+	r := newGrid[T](cols, rows)
+	for i, row := range g {
+		for j, v := range row {
+			r[j][rows-1-i] = v
+		}
+	}
+	return r, nil
+}
+
+// Reshape arranges a flat slice into a grid of the given dimensions, row by row.
+//
+// Parameters:
+//   - flat: The elements.
+//   - rows: The number of rows.
+//   - cols: The number of columns.
+//
+// Returns:
+//
+//	A new grid, and an error wrapping ErrInvalidShape if rows*cols does not equal len(flat).
+func Reshape[T any](flat []T, rows, cols int) ([][]T, error) {
+	if rows < 0 || cols < 0 || rows*cols != len(flat) {
+		return nil, fmt.Errorf("%w: cannot reshape %d elements into %dx%d", ErrInvalidShape, len(flat), rows, cols)
+	}
+	g := newGrid[T](rows, cols)
+	for i := range g {
+		copy(g[i], flat[i*cols:])
+	}
+	return g, nil
+}
+
+// FlattenGrid joins the rows of a grid into a single slice. Ragged grids are allowed.
+//
+// Parameters:
+//   - g: The grid.
+//
+// Returns:
+//
+//	A new slice with the elements of every row, in order.
+func FlattenGrid[T any](g [][]T) []T {
+	n := 0
+	for _, row := range g {
+		n += len(row)
+	}
+	flat := make([]T, 0, n)
+	for _, row := range g {
+		flat = append(flat, row...)
+	}
+	return flat
+}
+
+// Row returns a copy of a row of a grid.
+//
+// Parameters:
+//   - g: The grid.
+//   - i: The row index.
+//
+// Returns:
+//
+//	A copy of the row, and an error wrapping ErrIndexOutOfRange if i is out of range.
+func Row[T any](g [][]T, i int) ([]T, error) {
+	if i < 0 || i >= len(g) {
+		return nil, indexError(i, len(g))
+	}
+	return append([]T{}, g[i]...), nil
+}
+
+// Column returns a copy of a column of a grid.
+//
+// Parameters:
+//   - g: The grid, with rows of equal length.
+//   - j: The column index.
+//
+// Returns:
+//
+//	The column, and an error wrapping ErrRaggedGrid or ErrIndexOutOfRange.
+func Column[T any](g [][]T, j int) ([]T, error) {
+	cols, err := gridCols(g)
+	if err != nil {
+		return nil, err
+	}
+	if j < 0 || j >= cols {
+		return nil, indexError(j, cols)
+	}
+	return Map(g, func(row []T, _ int) T { return row[j] }), nil
+}
+
+// SubGrid copies a rectangular region out of a grid.
+//
+// Parameters:
+//   - g: The grid, with rows of equal length.
+//   - row: The first row of the region.
+//   - col: The first column of the region.
+//   - rows: The number of rows in the region.
+//   - cols: The number of columns in the region.
+//
+// Returns:
+//
+//	A new grid holding the region, and an error wrapping ErrRaggedGrid or ErrIndexOutOfRange if the region does not fit.
+func SubGrid[T any](g [][]T, row, col, rows, cols int) ([][]T, error) {
+	width, err := gridCols(g)
+	if err != nil {
+		return nil, err
+	}
+	if row < 0 || rows < 0 || row+rows > len(g) {
+		return nil, fmt.Errorf("%w: rows [%d:%d] of %d", ErrIndexOutOfRange, row, row+rows, len(g))
+	}
+	if col < 0 || cols < 0 || col+cols > width {
+		return nil, fmt.Errorf("%w: columns [%d:%d] of %d", ErrIndexOutOfRange, col, col+cols, width)
+	}
+	sub := newGrid[T](rows, cols)
+	for i := range sub {
+		copy(sub[i], g[row+i][col:col+cols])
+	}
+	return sub, nil
+}
+
+// Neighbors returns an iterator over the cells adjacent to a position, skipping positions outside the grid.
+// Ragged grids are allowed. Cells are yielded row by row, top-left first.
+//
+// Parameters:
+//   - g: The grid.
+//   - row: The row of the centre cell.
+//   - col: The column of the centre cell.
+//   - diagonal: Whether to include the four diagonal neighbours as well as the orthogonal ones.
+//
+// Returns:
+//
+//	An iterator over the neighbouring cells.
+//
+// Example:
+//
+//	for c := range Neighbors(board, 1, 1, true) {
+//		fmt.Println(c.Row, c.Col, c.Value)
+//	}
+func Neighbors[T any](g [][]T, row, col int, diagonal bool) iter.Seq[Cell[T]] {
+	return func(yield func(Cell[T]) bool) {
+		for dr := -1; dr <= 1; dr++ {
+			for dc := -1; dc <= 1; dc++ {
+				if (dr == 0 && dc == 0) || (!diagonal && dr != 0 && dc != 0) {
+					continue
+				}
+				r, c := row+dr, col+dc
+				if r < 0 || r >= len(g) || c < 0 || c >= len(g[r]) {
+					continue
+				}
+				if !yield(Cell[T]{Row: r, Col: c, Value: g[r][c]}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// NewAdvancedGrid wraps each row of a grid in an advanced slice.
+// The rows share storage with g.
+//
+// Parameters:
+//   - g: The grid.
+//
+// Returns:
+//
+//   - IAdvancedSlice[IAdvancedSlice[T]]: An advanced slice of rows.
+func NewAdvancedGrid[T any](g [][]T) IAdvancedSlice[IAdvancedSlice[T]] {
+	rows := Map(g, func(row []T, _ int) IAdvancedSlice[T] { return NewAdvancedSlice(row...) })
+	return NewAdvancedSlice(rows...)
+}
+
+// GridValues converts an advanced slice of rows back into a plain grid.
+//
+// Parameters:
+//   - g: The advanced slice of rows.
+//
+// Returns:
+//
+//	A grid holding the values of each row.
+func GridValues[T any](g IAdvancedSlice[IAdvancedSlice[T]]) [][]T {
+	return Map(g.Values(), func(row IAdvancedSlice[T], _ int) []T { return row.Values() })
+}
@@ -0,0 +1,109 @@
+package slice_test
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+var (
+	grid23 = [][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	}
+	ragged = [][]int{
+		{1, 2},
+		{3},
+	}
+)
+
+func TestTransposeRotate(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       func([][]int) ([][]int, error)
+		g       [][]int
+		want    [][]int
+		wantErr error
+	}{
+		{"transpose", slice.Transpose[int], grid23, [][]int{{1, 4}, {2, 5}, {3, 6}}, nil},
+		{"transpose empty", slice.Transpose[int], nil, [][]int{}, nil},
+		{"transpose ragged", slice.Transpose[int], ragged, nil, slice.ErrRaggedGrid},
+		{"rotate", slice.Rotate90[int], grid23, [][]int{{4, 1}, {5, 2}, {6, 3}}, nil},
+		{"rotate ragged", slice.Rotate90[int], ragged, nil, slice.ErrRaggedGrid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f(tt.g)
+			if !errors.Is(err, tt.wantErr) || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, %v, want %v, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReshapeFlatten(t *testing.T) {
+	got, err := slice.Reshape([]int{1, 2, 3, 4, 5, 6}, 2, 3)
+	if err != nil || !reflect.DeepEqual(got, grid23) {
+		t.Errorf("Reshape() = %v, %v, want %v", got, err, grid23)
+	}
+	if _, err := slice.Reshape([]int{1, 2, 3}, 2, 2); !errors.Is(err, slice.ErrInvalidShape) {
+		t.Errorf("Reshape() error = %v, want ErrInvalidShape", err)
+	}
+	if got, want := slice.FlattenGrid(ragged), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FlattenGrid() = %v, want %v", got, want)
+	}
+}
+
+func TestRowColumnSubGrid(t *testing.T) {
+	if got, err := slice.Row(grid23, 1); err != nil || !reflect.DeepEqual(got, []int{4, 5, 6}) {
+		t.Errorf("Row(1) = %v, %v", got, err)
+	}
+	if _, err := slice.Row(grid23, 2); !errors.Is(err, slice.ErrIndexOutOfRange) {
+		t.Errorf("Row(2) error = %v", err)
+	}
+	if got, err := slice.Column(grid23, 2); err != nil || !reflect.DeepEqual(got, []int{3, 6}) {
+		t.Errorf("Column(2) = %v, %v", got, err)
+	}
+	if _, err := slice.Column(ragged, 0); !errors.Is(err, slice.ErrRaggedGrid) {
+		t.Errorf("Column(ragged) error = %v", err)
+	}
+	if got, err := slice.SubGrid(grid23, 0, 1, 2, 2); err != nil || !reflect.DeepEqual(got, [][]int{{2, 3}, {5, 6}}) {
+		t.Errorf("SubGrid() = %v, %v", got, err)
+	}
+	if _, err := slice.SubGrid(grid23, 1, 1, 2, 1); !errors.Is(err, slice.ErrIndexOutOfRange) {
+		t.Errorf("SubGrid() out of range error = %v", err)
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	values := func(it func(func(slice.Cell[int]) bool)) []int {
+		var vs []int
+		for c := range it {
+			vs = append(vs, c.Value)
+		}
+		return vs
+	}
+	if got, want := values(slice.Neighbors(grid23, 0, 1, false)), []int{1, 3, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Neighbors(orthogonal) = %v, want %v", got, want)
+	}
+	if got, want := values(slice.Neighbors(grid23, 1, 0, true)), []int{1, 2, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Neighbors(diagonal) = %v, want %v", got, want)
+	}
+	if got := slices.Collect(slice.Neighbors(ragged, 0, 0, true)); len(got) != 2 {
+		t.Errorf("Neighbors(ragged) = %v, want 2 cells", got)
+	}
+}
+
+func TestAdvancedGrid(t *testing.T) {
+	g := slice.NewAdvancedGrid(grid23)
+	if g.Length() != 2 || g.At(1).At(2) != 6 {
+		t.Errorf("NewAdvancedGrid() = %v", g)
+	}
+	g.Reverse()
+	if got, want := slice.GridValues(g), [][]int{{4, 5, 6}, {1, 2, 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GridValues() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,219 @@
+package slice
+
+import (
+	"iter"
+)
+
+// Heap is a binary heap ordered by a comparison function.
+// The element for which less reports true against every other element is at the top,
+// so less(a, b) = a < b gives a min-heap, matching the ordering Sort would produce.
+type Heap[T any] struct {
+	data []T
+	less func(a, b T) bool
+}
+
+// NewHeap creates a new heap and heapifies the initial elements in O(n).
+//
+// Parameters:
+//   - less: A comparison function that determines the priority of elements, in the same form as the one passed to Sort.
+//   - data: The initial elements.
+//
+// Returns:
+//
+//   - *Heap[T]: The heap.
+//
+// Example:
+//
+//	h := NewHeap(func(a, b int) bool { return a < b }, 5, 1, 3)
+//	h.Pop() // 1
+func NewHeap[T any](less func(a, b T) bool, data ...T) *Heap[T] {
+	h := &Heap[T]{data: append([]T(nil), data...), less: less}
+	h.init()
+	return h
+}
+
+// NewHeapFrom creates a new heap from the elements of an advanced slice.
+// The advanced slice is left unchanged.
+//
+// Parameters:
+//   - s: The advanced slice to heapify.
+//   - less: A comparison function that determines the priority of elements.
+//
+// Returns:
+//
+//   - *Heap[T]: The heap.
+func NewHeapFrom[T any](s IAdvancedSlice[T], less func(a, b T) bool) *Heap[T] {
+	return NewHeap(less, s.Values()...)
+}
+
+// init establishes the heap invariant over all elements.
+func (h *Heap[T]) init() {
+	for i := len(h.data)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+}
+
+// up moves the element at i towards the root until its parent has higher priority.
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.data[i], h.data[parent]) {
+			break
+		}
+		h.data[i], h.data[parent] = h.data[parent], h.data[i]
+		i = parent
+	}
+}
+
+// down moves the element at i towards the leaves and reports whether it moved.
+func (h *Heap[T]) down(i int) bool {
+	start, n := i, len(h.data)
+	for {
+		child := 2*i + 1
+		if child >= n {
+			break
+		}
+		if right := child + 1; right < n && h.less(h.data[right], h.data[child]) {
+			child = right
+		}
+		if !h.less(h.data[child], h.data[i]) {
+			break
+		}
+		h.data[i], h.data[child] = h.data[child], h.data[i]
+		i = child
+	}
+	return i > start
+}
+
+// Length returns the number of elements in the heap.
+func (h *Heap[T]) Length() int {
+	return len(h.data)
+}
+
+// Values returns the elements in heap order, which is not sorted order.
+func (h *Heap[T]) Values() []T {
+	return append([]T(nil), h.data...)
+}
+
+// Push adds one or more elements to the heap.
+//
+// Parameters:
+//   - values: The elements to add.
+func (h *Heap[T]) Push(values ...T) {
+	for _, v := range values {
+		h.data = append(h.data, v)
+		h.up(len(h.data) - 1)
+	}
+}
+
+// Peek returns the top element without removing it.
+//
+// Returns:
+//
+//   - T: The top element, or the zero value if the heap is empty.
+//   - bool: Whether the heap was non-empty.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.data[0], true
+}
+
+// Pop removes and returns the top element.
+//
+// Returns:
+//
+//   - T: The top element, or the zero value if the heap is empty.
+func (h *Heap[T]) Pop() T {
+	v, _ := h.PopIs()
+	return v
+}
+
+// PopIs removes and returns the top element.
+//
+// Returns:
+//
+//   - T: The top element, or the zero value if the heap is empty.
+//   - bool: Whether an element was removed.
+func (h *Heap[T]) PopIs() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.Remove(0), true
+}
+
+// Remove removes and returns the element at index i in heap order.
+//
+// Parameters:
+//   - i: The index of the element, as seen in Values.
+//
+// Returns:
+//
+//   - T: The removed element, or the zero value if i is out of range.
+func (h *Heap[T]) Remove(i int) T {
+	var zero T
+	if i < 0 || i >= len(h.data) {
+		return zero
+	}
+	n := len(h.data) - 1
+	v := h.data[i]
+	if n != i {
+		h.data[i], h.data[n] = h.data[n], h.data[i]
+	}
+	h.data[n] = zero
+	h.data = h.data[:n]
+	if i < n {
+		h.Fix(i)
+	}
+	return v
+}
+
+// Fix re-establishes the heap ordering after the element at index i has changed its priority.
+//
+// Parameters:
+//   - i: The index of the changed element.
+func (h *Heap[T]) Fix(i int) {
+	if i < 0 || i >= len(h.data) {
+		return
+	}
+	if !h.down(i) {
+		h.up(i)
+	}
+}
+
+// Update replaces the element at index i and restores the heap ordering.
+//
+// Parameters:
+//   - i: The index of the element to replace.
+//   - value: The new element.
+func (h *Heap[T]) Update(i int, value T) {
+	if i < 0 || i >= len(h.data) {
+		return
+	}
+	h.data[i] = value
+	h.Fix(i)
+}
+
+// Drain returns an iterator that pops elements in priority order until the heap is empty
+// or the caller stops iterating.
+//
+// Returns:
+//
+//   - iter.Seq[T]: The iterator.
+//
+// Example:
+//
+//	for v := range h.Drain() {
+//		fmt.Println(v)
+//	}
+func (h *Heap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for len(h.data) > 0 {
+			if !yield(h.Pop()) {
+				return
+			}
+		}
+	}
+}
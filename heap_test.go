@@ -0,0 +1,85 @@
+package slice_test
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+func TestHeapDrain(t *testing.T) {
+	tests := []struct {
+		name string
+		data []int
+		want []int
+	}{
+		{"empty", nil, nil},
+		{"single", []int{1}, []int{1}},
+		{"unsorted", []int{5, 2, 8, 1, 9, 3}, []int{1, 2, 3, 5, 8, 9}},
+		{"duplicates", []int{2, 1, 2, 1}, []int{1, 1, 2, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := slice.NewHeap(intLess, tt.data...)
+			got := slices.Collect(h.Drain())
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Drain() = %v, want %v", got, tt.want)
+			}
+			if h.Length() != 0 {
+				t.Errorf("Length() = %d after Drain", h.Length())
+			}
+		})
+	}
+}
+
+func TestHeapOperations(t *testing.T) {
+	h := slice.NewHeapFrom(slice.NewAdvancedSlice(4, 7, 1), func(a, b int) bool { return a > b })
+	h.Push(9, 3)
+	if v, ok := h.Peek(); !ok || v != 9 {
+		t.Fatalf("Peek() = %v, %v, want 9", v, ok)
+	}
+	if v := h.Pop(); v != 9 {
+		t.Errorf("Pop() = %v, want 9", v)
+	}
+
+	i := slices.Index(h.Values(), 1)
+	h.Update(i, 10)
+	if v, _ := h.Peek(); v != 10 {
+		t.Errorf("Peek() = %v after Update, want 10", v)
+	}
+
+	i = slices.Index(h.Values(), 4)
+	if v := h.Remove(i); v != 4 {
+		t.Errorf("Remove() = %v, want 4", v)
+	}
+	if got, want := slices.Collect(h.Drain()), []int{10, 7, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Drain() = %v, want %v", got, want)
+	}
+	if _, ok := h.PopIs(); ok {
+		t.Errorf("PopIs() on empty heap returned ok")
+	}
+}
+
+func TestHeapRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	h := slice.NewHeap(intLess)
+	var want []int
+	for i := 0; i < 500; i++ {
+		v := r.Intn(100)
+		h.Push(v)
+		want = append(want, v)
+		if i%7 == 0 {
+			j := r.Intn(h.Length())
+			removed := h.Remove(j)
+			want = slices.Delete(want, slices.Index(want, removed), slices.Index(want, removed)+1)
+		}
+	}
+	slices.Sort(want)
+	if got := slices.Collect(h.Drain()); !reflect.DeepEqual(got, want) {
+		t.Errorf("Drain() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,502 @@
+package slice
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sort"
+)
+
+var _ IAdvancedSlice[any] = (*HistorySlice[any])(nil)
+
+// historyOp is one recorded mutation, as a pair of closures that reverse and reapply it.
+type historyOp struct {
+	undo func()
+	redo func()
+}
+
+// HistorySlice is an advanced slice that records its mutations so they can be undone and redone.
+// Each mutating call is one step. Where possible a step stores only what is needed to reverse it:
+// pushed values, removed elements and their indexes, permutations, or the overwritten elements of a Fill.
+// Map, Slice, SliceErr and CopyWithIn store a copy of the previous contents. Calls that change
+// nothing record no step, and a new mutation discards any steps that were undone.
+type HistorySlice[T any] struct {
+	*advancedSlice[T]
+	depth       int
+	undo        []historyOp
+	redo        []historyOp
+	dropped     int
+	checkpoints map[string]int
+}
+
+// NewHistorySlice creates a new history slice.
+//
+// Parameters:
+//   - depth: The maximum number of steps kept for Undo; the oldest are forgotten first. 0 means unlimited.
+//   - data: The initial elements. They are not recorded as a step.
+//
+// Returns:
+//
+//   - *HistorySlice[T]: The history slice.
+//
+// Example:
+//
+//	items := NewHistorySlice(100, "a", "b", "c")
+//	items.Checkpoint("loaded")
+//	items.RemoveAt(1)
+//	items.Undo() // [a b c]
+//	items.Redo() // [a c]
+//	_ = items.Restore("loaded")
+func NewHistorySlice[T any](depth int, data ...T) *HistorySlice[T] {
+	return &HistorySlice[T]{
+		advancedSlice: &advancedSlice[T]{data: data},
+		depth:         max(depth, 0),
+		checkpoints:   make(map[string]int),
+	}
+}
+
+// position returns the number of steps applied since the history began, including forgotten ones.
+func (s *HistorySlice[T]) position() int {
+	return s.dropped + len(s.undo)
+}
+
+// record adds a step that has already been applied.
+func (s *HistorySlice[T]) record(undo, redo func()) {
+	if len(s.redo) > 0 {
+		clear(s.redo)
+		s.redo = s.redo[:0]
+		for label, p := range s.checkpoints {
+			if p > s.position() {
+				delete(s.checkpoints, label)
+			}
+		}
+	}
+	s.undo = append(s.undo, historyOp{undo: undo, redo: redo})
+	if s.depth > 0 && len(s.undo) > s.depth {
+		s.undo[0] = historyOp{}
+		s.undo = s.undo[1:]
+		s.dropped++
+	}
+}
+
+// Undo reverses the most recent step.
+//
+// Returns:
+//
+//   - bool: False if there was nothing to undo.
+func (s *HistorySlice[T]) Undo() bool {
+	if len(s.undo) == 0 {
+		return false
+	}
+	op := s.undo[len(s.undo)-1]
+	s.undo[len(s.undo)-1] = historyOp{}
+	s.undo = s.undo[:len(s.undo)-1]
+	op.undo()
+	s.redo = append(s.redo, op)
+	return true
+}
+
+// Redo reapplies the most recently undone step.
+//
+// Returns:
+//
+//   - bool: False if there was nothing to redo.
+func (s *HistorySlice[T]) Redo() bool {
+	if len(s.redo) == 0 {
+		return false
+	}
+	op := s.redo[len(s.redo)-1]
+	s.redo[len(s.redo)-1] = historyOp{}
+	s.redo = s.redo[:len(s.redo)-1]
+	op.redo()
+	s.undo = append(s.undo, op)
+	return true
+}
+
+// CanUndo reports whether there is a step to undo.
+func (s *HistorySlice[T]) CanUndo() bool {
+	return len(s.undo) > 0
+}
+
+// CanRedo reports whether there is a step to redo.
+func (s *HistorySlice[T]) CanRedo() bool {
+	return len(s.redo) > 0
+}
+
+// Checkpoint labels the current state so that Restore can return to it.
+// Reusing a label moves it.
+//
+// Parameters:
+//   - label: The name of the checkpoint.
+//
+// Returns:
+//
+//   - *HistorySlice[T]: The history slice, for chaining.
+func (s *HistorySlice[T]) Checkpoint(label string) *HistorySlice[T] {
+	s.checkpoints[label] = s.position()
+	return s
+}
+
+// Restore undoes or redoes steps until the state labelled by Checkpoint is reached.
+//
+// Parameters:
+//   - label: The name of the checkpoint.
+//
+// Returns:
+//
+//   - error: An error wrapping ErrNoCheckpoint if the label is unknown or its steps are no longer
+//     in the history, because they were forgotten or discarded by a later mutation.
+func (s *HistorySlice[T]) Restore(label string) error {
+	p, ok := s.checkpoints[label]
+	if !ok || p < s.dropped || p > s.position()+len(s.redo) {
+		delete(s.checkpoints, label)
+		return fmt.Errorf("%w: %q", ErrNoCheckpoint, label)
+	}
+	for s.position() > p {
+		s.Undo()
+	}
+	for s.position() < p {
+		s.Redo()
+	}
+	return nil
+}
+
+// ClearHistory forgets every recorded step and checkpoint, keeping the current elements.
+func (s *HistorySlice[T]) ClearHistory() {
+	s.undo, s.redo, s.dropped = nil, nil, 0
+	clear(s.checkpoints)
+}
+
+// insertAt inserts values at index i.
+func (s *HistorySlice[T]) insertAt(i int, values ...T) {
+	s.data = slices.Insert(s.data, i, values...)
+}
+
+// deleteAt removes n elements at index i.
+func (s *HistorySlice[T]) deleteAt(i, n int) {
+	s.data = slices.Delete(s.data, i, i+n)
+}
+
+// recordSnapshot records a step that swaps between old and the current contents.
+func (s *HistorySlice[T]) recordSnapshot(old []T) {
+	swap := func() {
+		s.data, old = old, s.data
+	}
+	s.record(swap, swap)
+}
+
+// recordRemoved records the removal of values from the given, ascending, original indexes.
+func (s *HistorySlice[T]) recordRemoved(indexes []int, values []T) {
+	if len(indexes) == 0 {
+		return
+	}
+	s.record(func() {
+		for k, i := range indexes {
+			s.insertAt(i, values[k])
+		}
+	}, func() {
+		for k := len(indexes) - 1; k >= 0; k-- {
+			s.deleteAt(indexes[k], 1)
+		}
+	})
+}
+
+// applyPermutation reorders the elements so that the new element i is the previous element perm[i],
+// and records the step.
+func (s *HistorySlice[T]) applyPermutation(perm []int) {
+	if len(perm) < 2 {
+		return
+	}
+	inverse := make([]int, len(perm))
+	for i, p := range perm {
+		inverse[p] = i
+	}
+	permute(s.data, perm)
+	s.record(func() { permute(s.data, inverse) }, func() { permute(s.data, perm) })
+}
+
+// permute reorders s in place so that the new s[i] is the previous s[perm[i]].
+func permute[T any](s []T, perm []int) {
+	old := slices.Clone(s)
+	for i, p := range perm {
+		s[i] = old[p]
+	}
+}
+
+// Push appends the values and records the step.
+func (s *HistorySlice[T]) Push(values ...T) IAdvancedSlice[T] {
+	if len(values) == 0 {
+		return s
+	}
+	values = slices.Clone(values)
+	s.data = append(s.data, values...)
+	s.record(func() {
+		s.deleteAt(len(s.data)-len(values), len(values))
+	}, func() {
+		s.data = append(s.data, values...)
+	})
+	return s
+}
+
+// PushSlice appends the elements of the given slices as a single step.
+func (s *HistorySlice[T]) PushSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	return s.Push(Concat(Map(values, func(v IAdvancedSlice[T], _ int) []T { return v.Values() })...)...)
+}
+
+// Concat appends the elements of the given slices as a single step.
+func (s *HistorySlice[T]) Concat(ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	return s.PushSlice(ss...)
+}
+
+// Unshift prepends the values and records the step.
+func (s *HistorySlice[T]) Unshift(values ...T) IAdvancedSlice[T] {
+	if len(values) == 0 {
+		return s
+	}
+	values = slices.Clone(values)
+	s.insertAt(0, values...)
+	s.record(func() {
+		s.deleteAt(0, len(values))
+	}, func() {
+		s.insertAt(0, values...)
+	})
+	return s
+}
+
+// UnshiftSlice prepends the elements of each given slice in turn, one step per slice.
+func (s *HistorySlice[T]) UnshiftSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	for _, v := range values {
+		s.Unshift(v.Values()...)
+	}
+	return s
+}
+
+// Pop removes and returns the last element, recording the step.
+func (s *HistorySlice[T]) Pop() T {
+	if len(s.data) == 0 {
+		return s.advancedSlice.Pop()
+	}
+	v, _ := s.PopIs()
+	return v
+}
+
+// PopIs removes and returns the last element, recording the step.
+func (s *HistorySlice[T]) PopIs() (T, bool) {
+	v, ok := s.advancedSlice.PopIs()
+	if ok {
+		s.recordRemoved([]int{len(s.data)}, []T{v})
+	}
+	return v, ok
+}
+
+// PopErr removes and returns the last element, recording the step.
+func (s *HistorySlice[T]) PopErr() (T, error) {
+	v, err := s.advancedSlice.PopErr()
+	if err == nil {
+		s.recordRemoved([]int{len(s.data)}, []T{v})
+	}
+	return v, err
+}
+
+// Shift removes and returns the first element, recording the step.
+func (s *HistorySlice[T]) Shift() T {
+	if len(s.data) == 0 {
+		return s.advancedSlice.Shift()
+	}
+	v, _ := s.ShiftIs()
+	return v
+}
+
+// ShiftIs removes and returns the first element, recording the step.
+func (s *HistorySlice[T]) ShiftIs() (T, bool) {
+	v, ok := s.advancedSlice.ShiftIs()
+	if ok {
+		s.recordRemoved([]int{0}, []T{v})
+	}
+	return v, ok
+}
+
+// ShiftErr removes and returns the first element, recording the step.
+func (s *HistorySlice[T]) ShiftErr() (T, error) {
+	v, err := s.advancedSlice.ShiftErr()
+	if err == nil {
+		s.recordRemoved([]int{0}, []T{v})
+	}
+	return v, err
+}
+
+// RemoveAt removes the element at index and records the step.
+func (s *HistorySlice[T]) RemoveAt(index int) IAdvancedSlice[T] {
+	if i, ok := s.resolve(index); ok {
+		s.removeIndex(i)
+	}
+	return s
+}
+
+// RemoveAtErr removes the element at index and records the step, or returns an error as RemoveAtErr does.
+func (s *HistorySlice[T]) RemoveAtErr(index int) (IAdvancedSlice[T], error) {
+	i, err := resolveIndexErr(len(s.data), index, s.bounds)
+	if err != nil {
+		return s, err
+	}
+	s.removeIndex(i)
+	return s, nil
+}
+
+// removeIndex removes the element at a resolved index and records the step.
+func (s *HistorySlice[T]) removeIndex(i int) {
+	v := s.data[i]
+	s.deleteAt(i, 1)
+	s.recordRemoved([]int{i}, []T{v})
+}
+
+// Remove removes the elements that satisfy f as a single step.
+func (s *HistorySlice[T]) Remove(f func(T, int) bool) IAdvancedSlice[T] {
+	s.removeMatching(f)
+	return s
+}
+
+// removeMatching removes the elements that satisfy f and records their indexes and values.
+func (s *HistorySlice[T]) removeMatching(f func(T, int) bool) {
+	var indexes []int
+	var values []T
+	kept := make([]T, 0, len(s.data))
+	for i, v := range s.data {
+		if f(v, i) {
+			indexes = append(indexes, i)
+			values = append(values, v)
+		} else {
+			kept = append(kept, v)
+		}
+	}
+	s.data = kept
+	s.recordRemoved(indexes, values)
+}
+
+// Unique keeps the first occurrence of each key returned by f, recording the removed elements.
+func (s *HistorySlice[T]) Unique(f func(T) string) IAdvancedSlice[T] {
+	if f == nil {
+		f = s.key
+	}
+	if f == nil {
+		return s
+	}
+	seen := make(map[string]struct{}, len(s.data))
+	s.removeMatching(func(v T, _ int) bool {
+		k := f(v)
+		if _, ok := seen[k]; ok {
+			return true
+		}
+		seen[k] = struct{}{}
+		return false
+	})
+	return s
+}
+
+// Map replaces each element with the result of f, recording a copy of the previous elements.
+func (s *HistorySlice[T]) Map(f func(T, int) T) IAdvancedSlice[T] {
+	if len(s.data) == 0 {
+		return s
+	}
+	old := slices.Clone(s.data)
+	s.advancedSlice.Map(f)
+	s.recordSnapshot(old)
+	return s
+}
+
+// Fill sets the selected elements to value, recording only the elements it overwrites.
+func (s *HistorySlice[T]) Fill(value T, index ...int) IAdvancedSlice[T] {
+	// Run Fill over positions to learn which elements it touches, then apply it for real.
+	positions := Fill(identity(len(s.data)), -1, index...)
+	var changed []int
+	var old []T
+	for i, p := range positions {
+		if p == -1 {
+			changed = append(changed, i)
+			old = append(old, s.data[i])
+		}
+	}
+	s.advancedSlice.Fill(value, index...)
+	if len(changed) == 0 {
+		return s
+	}
+	s.record(func() {
+		for k, i := range changed {
+			s.data[i] = old[k]
+		}
+	}, func() {
+		s.advancedSlice.Fill(value, index...)
+	})
+	return s
+}
+
+// Sort sorts the elements using f, stably, recording the permutation.
+func (s *HistorySlice[T]) Sort(f func(T, T) bool) IAdvancedSlice[T] {
+	if f == nil {
+		f = s.less
+	}
+	if f == nil {
+		return s
+	}
+	perm := identity(len(s.data))
+	sort.SliceStable(perm, func(i, j int) bool {
+		return f(s.data[perm[i]], s.data[perm[j]])
+	})
+	s.applyPermutation(perm)
+	return s
+}
+
+// Reverse reverses the order of the elements and records the step.
+func (s *HistorySlice[T]) Reverse() IAdvancedSlice[T] {
+	if len(s.data) < 2 {
+		return s
+	}
+	reverse := func() { Reverse(s.data) }
+	reverse()
+	s.record(reverse, reverse)
+	return s
+}
+
+// Shuffle randomly permutes the elements in place, recording the permutation.
+func (s *HistorySlice[T]) Shuffle(r *rand.Rand) IAdvancedSlice[T] {
+	s.applyPermutation(Shuffle(identity(len(s.data)), r))
+	return s
+}
+
+// Slice keeps only the selected subset, recording a copy of the previous elements.
+func (s *HistorySlice[T]) Slice(index ...int) IAdvancedSlice[T] {
+	old := slices.Clone(s.data)
+	s.advancedSlice.Slice(index...)
+	s.recordSnapshot(old)
+	return s
+}
+
+// SliceErr keeps only the selected subset, or returns an error as SliceErr does.
+func (s *HistorySlice[T]) SliceErr(index ...int) (IAdvancedSlice[T], error) {
+	old := slices.Clone(s.data)
+	_, err := s.advancedSlice.SliceErr(index...)
+	if err == nil {
+		s.recordSnapshot(old)
+	}
+	return s, err
+}
+
+// CopyWithIn keeps only the elements at the given indices, recording a copy of the previous elements.
+func (s *HistorySlice[T]) CopyWithIn(indexes ...int) IAdvancedSlice[T] {
+	old := slices.Clone(s.data)
+	s.advancedSlice.CopyWithIn(indexes...)
+	s.recordSnapshot(old)
+	return s
+}
+
+// Begin starts a transaction over the slice.
+func (s *HistorySlice[T]) Begin(validators ...Validator[T]) *Tx[T] {
+	return Begin[T](s, validators...)
+}
+
+// replace swaps in new contents as a single step.
+func (s *HistorySlice[T]) replace(values []T) {
+	old := s.data
+	s.data = values
+	s.recordSnapshot(old)
+}
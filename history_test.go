@@ -0,0 +1,139 @@
+package slice_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestHistorySliceUndoRedo(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	tests := []struct {
+		name   string
+		mutate func(s *slice.HistorySlice[int])
+		want   []int
+	}{
+		{"Push", func(s *slice.HistorySlice[int]) { s.Push(9, 8) }, []int{3, 1, 4, 1, 5, 9, 8}},
+		{"Unshift", func(s *slice.HistorySlice[int]) { s.Unshift(0) }, []int{0, 3, 1, 4, 1, 5}},
+		{"Pop", func(s *slice.HistorySlice[int]) { s.Pop() }, []int{3, 1, 4, 1}},
+		{"Shift", func(s *slice.HistorySlice[int]) { s.Shift() }, []int{1, 4, 1, 5}},
+		{"RemoveAt", func(s *slice.HistorySlice[int]) { s.RemoveAt(2) }, []int{3, 1, 1, 5}},
+		{"Remove", func(s *slice.HistorySlice[int]) { s.Remove(func(v, _ int) bool { return v == 1 }) }, []int{3, 4, 5}},
+		{"Unique", func(s *slice.HistorySlice[int]) { s.Unique(func(v int) string { return string(rune('0' + v)) }) }, []int{3, 1, 4, 5}},
+		{"Sort", func(s *slice.HistorySlice[int]) { s.Sort(less) }, []int{1, 1, 3, 4, 5}},
+		{"Reverse", func(s *slice.HistorySlice[int]) { s.Reverse() }, []int{5, 1, 4, 1, 3}},
+		{"Fill", func(s *slice.HistorySlice[int]) { s.Fill(0, 1, 3) }, []int{3, 0, 0, 1, 5}},
+		{"Fill negative", func(s *slice.HistorySlice[int]) { s.Fill(0, -3) }, []int{3, 1, 0, 0, 0}},
+		{"Fill all", func(s *slice.HistorySlice[int]) { s.Fill(7) }, []int{7, 7, 7, 7, 7}},
+		{"Map", func(s *slice.HistorySlice[int]) { s.Map(func(v, _ int) int { return v * 2 }) }, []int{6, 2, 8, 2, 10}},
+		{"Slice", func(s *slice.HistorySlice[int]) { s.Slice(1, 3) }, []int{1, 4}},
+		{"CopyWithIn", func(s *slice.HistorySlice[int]) { s.CopyWithIn(4, 0) }, []int{5, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := []int{3, 1, 4, 1, 5}
+			s := slice.NewHistorySlice(0, append([]int(nil), original...)...)
+			tt.mutate(s)
+			if got := s.Values(); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("after mutation = %v, want %v", got, tt.want)
+			}
+			if !s.Undo() {
+				t.Fatal("Undo() = false")
+			}
+			if got := s.Values(); !reflect.DeepEqual(got, original) {
+				t.Errorf("after Undo = %v, want %v", got, original)
+			}
+			if !s.Redo() {
+				t.Fatal("Redo() = false")
+			}
+			if got := s.Values(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("after Redo = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHistorySliceSequence(t *testing.T) {
+	s := slice.NewHistorySlice(0, 1, 2, 3)
+	s.Push(4).RemoveAt(0)
+	s.Pop()
+	s.Push() // no change, no step
+	s.Pop()
+	if got := s.Values(); !reflect.DeepEqual(got, []int{2}) {
+		t.Fatalf("Values() = %v", got)
+	}
+	for s.Undo() {
+	}
+	if got := s.Values(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("after undoing everything = %v", got)
+	}
+	s.Redo()
+	s.Redo()
+	s.Unshift(0) // discards the remaining redo steps
+	if s.CanRedo() {
+		t.Error("CanRedo() = true after a new mutation")
+	}
+	if got := s.Values(); !reflect.DeepEqual(got, []int{0, 2, 3, 4}) {
+		t.Errorf("Values() = %v, want [0 2 3 4]", got)
+	}
+}
+
+func TestHistorySliceDepth(t *testing.T) {
+	s := slice.NewHistorySlice[int](2)
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	undone := 0
+	for s.Undo() {
+		undone++
+	}
+	if undone != 2 || !reflect.DeepEqual(s.Values(), []int{1}) {
+		t.Errorf("undone %d steps to %v, want 2 steps to [1]", undone, s.Values())
+	}
+}
+
+func TestHistorySliceCheckpoints(t *testing.T) {
+	s := slice.NewHistorySlice(3, "a", "b")
+	s.Checkpoint("start")
+	s.Push("c")
+	s.Checkpoint("pushed")
+	s.Reverse()
+	s.RemoveAt(0)
+
+	if err := s.Restore("start"); err != nil {
+		t.Fatalf("Restore(start) error = %v", err)
+	}
+	if got := s.Values(); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Restore(start) = %v", got)
+	}
+	if err := s.Restore("pushed"); err != nil {
+		t.Fatalf("Restore(pushed) error = %v", err)
+	}
+	if got := s.Values(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("Restore(pushed) = %v", got)
+	}
+
+	if err := s.Restore("missing"); !errors.Is(err, slice.ErrNoCheckpoint) {
+		t.Errorf("Restore(missing) error = %v", err)
+	}
+	s.Restore("start")
+	s.Push("x") // discards the steps after start
+	if err := s.Restore("pushed"); !errors.Is(err, slice.ErrNoCheckpoint) {
+		t.Errorf("Restore(discarded) error = %v", err)
+	}
+	s.Push("y")
+	s.Push("z")
+	s.Push("w") // depth 3 forgets the step after start
+	if err := s.Restore("start"); !errors.Is(err, slice.ErrNoCheckpoint) {
+		t.Errorf("Restore(forgotten) error = %v", err)
+	}
+}
+
+func TestHistorySliceChaining(t *testing.T) {
+	s := slice.NewHistorySlice(0, 2, 1)
+	if got := s.Push(3).Sort(func(a, b int) bool { return a < b }); got != s {
+		t.Errorf("chained call returned %T, want the receiver", got)
+	}
+}
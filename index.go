@@ -0,0 +1,395 @@
+package slice
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+)
+
+// ConflictPolicy decides which element is kept when IndexBy meets two elements with the same key.
+type ConflictPolicy int
+
+const (
+	// ConflictFirstWins keeps the first element seen for a key.
+	ConflictFirstWins ConflictPolicy = iota
+	// ConflictLastWins keeps the last element seen for a key.
+	ConflictLastWins
+	// ConflictError stops indexing and returns ErrDuplicateKey.
+	ConflictError
+)
+
+// IndexBy builds a map from key to element.
+//
+// Parameters:
+//   - s: The slice to index.
+//   - key: A function that extracts a key from each element.
+//   - policy: An optional ConflictPolicy, ConflictFirstWins by default.
+//
+// Returns:
+//
+//	A map from key to element, and an error wrapping ErrDuplicateKey if the ConflictError policy is used and a key repeats.
+func IndexBy[T any, K comparable](s []T, key func(T) K, policy ...ConflictPolicy) (map[K]T, error) {
+	p := ConflictFirstWins
+	if len(policy) > 0 {
+		p = policy[0]
+	}
+	m := make(map[K]T, len(s))
+	for _, v := range s {
+		k := key(v)
+		if _, ok := m[k]; ok {
+			switch p {
+			case ConflictFirstWins:
+				continue
+			case ConflictError:
+				return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, k)
+			}
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+// MultiIndex groups the elements of the slice by key.
+//
+// Parameters:
+//   - s: The slice to index.
+//   - key: A function that extracts a key from each element.
+//
+// Returns:
+//
+//	A map from key to all elements with that key, in their original order.
+func MultiIndex[T any, K comparable](s []T, key func(T) K) map[K][]T {
+	m := make(map[K][]T)
+	for _, v := range s {
+		k := key(v)
+		m[k] = append(m[k], v)
+	}
+	return m
+}
+
+// ToMap converts the slice into a map using a key/value function.
+//
+// Parameters:
+//   - s: The slice to convert.
+//   - kv: A function that returns the key and value for each element. Later keys overwrite earlier ones.
+//
+// Returns:
+//
+//	A map containing the key/value pairs.
+func ToMap[T any, K comparable, V any](s []T, kv func(T) (K, V)) map[K]V {
+	m := make(map[K]V, len(s))
+	for _, v := range s {
+		k, val := kv(v)
+		m[k] = val
+	}
+	return m
+}
+
+// FromMap converts a map into a slice, visiting the keys in ascending order so the result is deterministic.
+//
+// Parameters:
+//   - m: The map to convert.
+//   - f: A function that builds an element from a key and its value.
+//
+// Returns:
+//
+//	A slice with one element per map entry, ordered by key.
+func FromMap[K cmp.Ordered, V, T any](m map[K]V, f func(K, V) T) []T {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	list := make([]T, 0, len(m))
+	for _, k := range keys {
+		list = append(list, f(k, m[k]))
+	}
+	return list
+}
+
+var _ IAdvancedSlice[any] = (*IndexedSlice[any, string])(nil)
+
+// IndexedSlice is an advanced slice that keeps a hash index from key to position,
+// so elements can be looked up by key in O(1).
+// When several elements share a key, the index points at the first of them.
+type IndexedSlice[T any, K comparable] struct {
+	*advancedSlice[T]
+	key   func(T) K
+	index map[K]int
+}
+
+// NewIndexedSlice creates a new indexed slice.
+//
+// Parameters:
+//   - key: A function that extracts the index key from each element.
+//   - data: The initial elements.
+//
+// Returns:
+//
+//   - *IndexedSlice[T, K]: The indexed slice.
+//
+// Example:
+//
+//	users := NewIndexedSlice(func(u User) int { return u.ID }, list...)
+//	u, ok := users.Get(42)
+func NewIndexedSlice[T any, K comparable](key func(T) K, data ...T) *IndexedSlice[T, K] {
+	s := &IndexedSlice[T, K]{
+		advancedSlice: &advancedSlice[T]{data: data},
+		key:           key,
+	}
+	s.reindex()
+	return s
+}
+
+// reindex rebuilds the index from scratch.
+func (s *IndexedSlice[T, K]) reindex() {
+	s.index = make(map[K]int, len(s.data))
+	for i, v := range s.data {
+		k := s.key(v)
+		if _, ok := s.index[k]; !ok {
+			s.index[k] = i
+		}
+	}
+}
+
+// Get returns the first element with the given key.
+//
+// Parameters:
+//   - key: The key to look up.
+//
+// Returns:
+//
+//   - T: The element, or the zero value if the key is absent.
+//   - bool: Whether the key was found.
+func (s *IndexedSlice[T, K]) Get(key K) (T, bool) {
+	i, ok := s.index[key]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return s.data[i], true
+}
+
+// IndexOfKey returns the position of the first element with the given key.
+//
+// Parameters:
+//   - key: The key to look up.
+//
+// Returns:
+//
+//   - int (index): The position, or -1 if the key is absent.
+func (s *IndexedSlice[T, K]) IndexOfKey(key K) int {
+	if i, ok := s.index[key]; ok {
+		return i
+	}
+	return -1
+}
+
+// HasKey reports whether an element with the given key exists.
+//
+// Parameters:
+//   - key: The key to look up.
+//
+// Returns:
+//
+//   - bool: true if the key is present.
+func (s *IndexedSlice[T, K]) HasKey(key K) bool {
+	_, ok := s.index[key]
+	return ok
+}
+
+// Map applies a transformation function to each element and rebuilds the index.
+func (s *IndexedSlice[T, K]) Map(f func(T, int) T) IAdvancedSlice[T] {
+	s.advancedSlice.Map(f)
+	s.reindex()
+	return s
+}
+
+// Unique keeps the first occurrence of each key returned by f and rebuilds the index.
+func (s *IndexedSlice[T, K]) Unique(f func(T) string) IAdvancedSlice[T] {
+	s.advancedSlice.Unique(f)
+	s.reindex()
+	return s
+}
+
+// Concat appends the elements of the given slices and indexes them.
+func (s *IndexedSlice[T, K]) Concat(ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	for _, a := range ss {
+		s.Push(a.Values()...)
+	}
+	return s
+}
+
+// CopyWithIn keeps only the elements at the given indices and rebuilds the index.
+func (s *IndexedSlice[T, K]) CopyWithIn(indexes ...int) IAdvancedSlice[T] {
+	s.advancedSlice.CopyWithIn(indexes...)
+	s.reindex()
+	return s
+}
+
+// Slice keeps only the selected subset and rebuilds the index.
+func (s *IndexedSlice[T, K]) Slice(index ...int) IAdvancedSlice[T] {
+	s.advancedSlice.Slice(index...)
+	s.reindex()
+	return s
+}
+
+// Fill sets the selected elements to value and rebuilds the index.
+func (s *IndexedSlice[T, K]) Fill(value T, index ...int) IAdvancedSlice[T] {
+	s.advancedSlice.Fill(value, index...)
+	s.reindex()
+	return s
+}
+
+// Sort sorts the elements and rebuilds the index.
+func (s *IndexedSlice[T, K]) Sort(f func(T, T) bool) IAdvancedSlice[T] {
+	s.advancedSlice.Sort(f)
+	s.reindex()
+	return s
+}
+
+// Pop removes and returns the last element, updating the index in O(1).
+func (s *IndexedSlice[T, K]) Pop() T {
+	v, ok := s.PopIs()
+	if !ok {
+		s.empty()
+	}
+	return v
+}
+
+// PopIs removes and returns the last element, updating the index in O(1).
+func (s *IndexedSlice[T, K]) PopIs() (T, bool) {
+	v, ok := s.advancedSlice.PopIs()
+	if !ok {
+		return v, false
+	}
+	k := s.key(v)
+	if s.index[k] == len(s.data) {
+		delete(s.index, k)
+	}
+	return v, true
+}
+
+// Push appends the values and indexes them in O(1) per element.
+func (s *IndexedSlice[T, K]) Push(values ...T) IAdvancedSlice[T] {
+	for _, v := range values {
+		k := s.key(v)
+		if _, ok := s.index[k]; !ok {
+			s.index[k] = len(s.data)
+		}
+		s.data = append(s.data, v)
+	}
+	return s
+}
+
+// PushSlice appends the elements of the given slices and indexes them.
+func (s *IndexedSlice[T, K]) PushSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	return s.Concat(values...)
+}
+
+// Shift removes and returns the first element and rebuilds the index.
+func (s *IndexedSlice[T, K]) Shift() T {
+	v, ok := s.ShiftIs()
+	if !ok {
+		s.empty()
+	}
+	return v
+}
+
+// ShiftIs removes and returns the first element and rebuilds the index.
+func (s *IndexedSlice[T, K]) ShiftIs() (T, bool) {
+	v, ok := s.advancedSlice.ShiftIs()
+	if ok {
+		s.reindex()
+	}
+	return v, ok
+}
+
+// Unshift prepends the values and rebuilds the index.
+func (s *IndexedSlice[T, K]) Unshift(values ...T) IAdvancedSlice[T] {
+	s.advancedSlice.Unshift(values...)
+	s.reindex()
+	return s
+}
+
+// UnshiftSlice prepends the elements of the given slices and rebuilds the index.
+func (s *IndexedSlice[T, K]) UnshiftSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	s.advancedSlice.UnshiftSlice(values...)
+	s.reindex()
+	return s
+}
+
+// Reverse reverses the elements and rebuilds the index.
+func (s *IndexedSlice[T, K]) Reverse() IAdvancedSlice[T] {
+	s.advancedSlice.Reverse()
+	s.reindex()
+	return s
+}
+
+// Shuffle randomly permutes the elements in place and rebuilds the index.
+func (s *IndexedSlice[T, K]) Shuffle(r *rand.Rand) IAdvancedSlice[T] {
+	s.advancedSlice.Shuffle(r)
+	s.reindex()
+	return s
+}
+
+// Remove removes the elements matching f and rebuilds the index.
+func (s *IndexedSlice[T, K]) Remove(f func(T, int) bool) IAdvancedSlice[T] {
+	s.advancedSlice.Remove(f)
+	s.reindex()
+	return s
+}
+
+// RemoveAt removes the element at index and rebuilds the index.
+func (s *IndexedSlice[T, K]) RemoveAt(index int) IAdvancedSlice[T] {
+	s.advancedSlice.RemoveAt(index)
+	s.reindex()
+	return s
+}
+
+// RemoveAtErr removes the element at index and rebuilds the index, or returns an error as RemoveAtErr does.
+func (s *IndexedSlice[T, K]) RemoveAtErr(index int) (IAdvancedSlice[T], error) {
+	if _, err := s.advancedSlice.RemoveAtErr(index); err != nil {
+		return s, err
+	}
+	s.reindex()
+	return s, nil
+}
+
+// PopErr removes and returns the last element, updating the index, or returns ErrEmpty.
+func (s *IndexedSlice[T, K]) PopErr() (T, error) {
+	if s.Length() == 0 {
+		return s.advancedSlice.PopErr()
+	}
+	return s.Pop(), nil
+}
+
+// ShiftErr removes and returns the first element and rebuilds the index, or returns ErrEmpty.
+func (s *IndexedSlice[T, K]) ShiftErr() (T, error) {
+	v, err := s.advancedSlice.ShiftErr()
+	if err == nil {
+		s.reindex()
+	}
+	return v, err
+}
+
+// SliceErr keeps only the selected subset and rebuilds the index, or returns an error as SliceErr does.
+func (s *IndexedSlice[T, K]) SliceErr(index ...int) (IAdvancedSlice[T], error) {
+	if _, err := s.advancedSlice.SliceErr(index...); err != nil {
+		return s, err
+	}
+	s.reindex()
+	return s, nil
+}
+
+// Begin starts a transaction over the slice.
+func (s *IndexedSlice[T, K]) Begin(validators ...Validator[T]) *Tx[T] {
+	return Begin[T](s, validators...)
+}
+
+// replace swaps in new contents and rebuilds the index.
+func (s *IndexedSlice[T, K]) replace(values []T) {
+	s.data = values
+	s.reindex()
+}
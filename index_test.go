@@ -0,0 +1,101 @@
+package slice_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+type indexItem struct {
+	ID   int
+	Name string
+}
+
+func TestIndexBy(t *testing.T) {
+	items := []indexItem{{1, "a"}, {2, "b"}, {1, "c"}}
+	key := func(v indexItem) int { return v.ID }
+	tests := []struct {
+		name    string
+		policy  []slice.ConflictPolicy
+		want    map[int]indexItem
+		wantErr error
+	}{
+		{"default first wins", nil, map[int]indexItem{1: {1, "a"}, 2: {2, "b"}}, nil},
+		{"first wins", []slice.ConflictPolicy{slice.ConflictFirstWins}, map[int]indexItem{1: {1, "a"}, 2: {2, "b"}}, nil},
+		{"last wins", []slice.ConflictPolicy{slice.ConflictLastWins}, map[int]indexItem{1: {1, "c"}, 2: {2, "b"}}, nil},
+		{"error", []slice.ConflictPolicy{slice.ConflictError}, nil, slice.ErrDuplicateKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := slice.IndexBy(items, key, tt.policy...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("IndexBy() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IndexBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiIndex(t *testing.T) {
+	items := []indexItem{{1, "a"}, {2, "b"}, {1, "c"}}
+	got := slice.MultiIndex(items, func(v indexItem) int { return v.ID })
+	want := map[int][]indexItem{1: {{1, "a"}, {1, "c"}}, 2: {{2, "b"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MultiIndex() = %v, want %v", got, want)
+	}
+}
+
+func TestToMapFromMap(t *testing.T) {
+	items := []indexItem{{3, "c"}, {1, "a"}, {2, "b"}}
+	m := slice.ToMap(items, func(v indexItem) (int, string) { return v.ID, v.Name })
+	if want := map[int]string{1: "a", 2: "b", 3: "c"}; !reflect.DeepEqual(m, want) {
+		t.Fatalf("ToMap() = %v, want %v", m, want)
+	}
+	got := slice.FromMap(m, func(k int, v string) string { return strconv.Itoa(k) + v })
+	if want := []string{"1a", "2b", "3c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FromMap() = %v, want %v", got, want)
+	}
+}
+
+func TestIndexedSlice(t *testing.T) {
+	s := slice.NewIndexedSlice(func(v indexItem) string { return v.Name }, indexItem{1, "a"}, indexItem{2, "b"})
+
+	s.Push(indexItem{3, "c"})
+	if v, ok := s.Get("c"); !ok || v.ID != 3 {
+		t.Fatalf("Get(c) = %v, %v after Push", v, ok)
+	}
+
+	s.RemoveAt(0)
+	if s.HasKey("a") {
+		t.Errorf("HasKey(a) = true after RemoveAt(0)")
+	}
+	if i := s.IndexOfKey("c"); i != 1 {
+		t.Errorf("IndexOfKey(c) = %d, want 1", i)
+	}
+
+	s.Remove(func(v indexItem, _ int) bool { return v.Name == "b" })
+	if s.HasKey("b") {
+		t.Errorf("HasKey(b) = true after Remove")
+	}
+
+	if v := s.Pop(); v.Name != "c" || s.HasKey("c") {
+		t.Errorf("Pop() = %v, HasKey(c) = %v", v, s.HasKey("c"))
+	}
+	if s.Length() != 0 {
+		t.Errorf("Length() = %d, want 0", s.Length())
+	}
+
+	s.Push(indexItem{4, "d"}, indexItem{5, "d"})
+	if v, _ := s.Get("d"); v.ID != 4 {
+		t.Errorf("Get(d) = %v, want first occurrence", v)
+	}
+	s.Pop()
+	if !s.HasKey("d") {
+		t.Errorf("HasKey(d) = false after popping a later duplicate")
+	}
+}
@@ -2,6 +2,7 @@ package slice
 
 import (
 	"fmt"
+	"math/rand/v2"
 )
 
 // IAdvancedSlice defines an interface for advanced slice manipulation.
@@ -28,6 +29,7 @@ type IAdvancedSlice[T any] interface {
 	//
 	// Parameters:
 	//   - f: A function that extracts a key from each element of type T. The key must be a string.
+	//     If nil, the key function configured with WithKey or SetKey is used; without one the slice is left unchanged.
 	//
 	// Returns:
 	//   A new IAdvancedSlice[T] containing only the first occurrence of each unique key.
@@ -121,10 +123,12 @@ type IAdvancedSlice[T any] interface {
 	Slice(index ...int) IAdvancedSlice[T]
 
 	// Fill sets all elements of the slice to a specified value, optionally at specified indices.
+	// Negative indices count from the end, as in the package-level Fill; the order of the elements never changes.
+	// A begin or end beyond either end of the slice is clamped, and is also reported under the bounds policy.
 	//
 	// Parameters:
 	//   - value: The value to set.
-	//   - indexes: An optional variadic parameter specifying the indices to fill.
+	//   - indexes: An optional variadic parameter specifying the begin, end and step indices.
 	//
 	// Returns:
 	//   A new IAdvancedSlice[T] with the specified elements filled.
@@ -133,7 +137,7 @@ type IAdvancedSlice[T any] interface {
 	// At returns the element at the specified index in the slice.
 	//
 	// Parameters:
-	//   - index: The index of the element to retrieve.
+	//   - index: The index of the element to retrieve. A negative index counts from the end.
 	//
 	// Returns:
 	//   The element at the specified index.
@@ -143,6 +147,7 @@ type IAdvancedSlice[T any] interface {
 	//
 	// Parameters:
 	//   - f: A comparison function that determines the order of elements.
+	//     If nil, the comparator configured with WithComparator or SetComparator is used; without one the slice is left unchanged.
 	//
 	// Returns:
 	//   A new IAdvancedSlice[T] containing the sorted elements.
@@ -231,6 +236,45 @@ type IAdvancedSlice[T any] interface {
 	//   The reversed slice.
 	Reverse() IAdvancedSlice[T]
 
+	// Shuffle randomly permutes the elements of the slice in place.
+	//
+	// Parameters:
+	//   - r: The source of randomness, or nil to use the global source.
+	//
+	// Returns:
+	//   The shuffled slice.
+	Shuffle(r *rand.Rand) IAdvancedSlice[T]
+
+	// Sample returns n distinct elements chosen at random, without replacement.
+	//
+	// Parameters:
+	//   - n: The number of elements to choose, capped at the length of the slice.
+	//   - r: The source of randomness, or nil to use the global source.
+	//
+	// Returns:
+	//   A new slice with the chosen elements.
+	Sample(n int, r *rand.Rand) []T
+
+	// SampleWithReplacement returns n elements chosen independently at random.
+	//
+	// Parameters:
+	//   - n: The number of elements to choose.
+	//   - r: The source of randomness, or nil to use the global source.
+	//
+	// Returns:
+	//   A new slice with the chosen elements.
+	SampleWithReplacement(n int, r *rand.Rand) []T
+
+	// WeightedChoice chooses one element at random with probability proportional to its weight.
+	//
+	// Parameters:
+	//   - weight: A function that returns the weight of each element. Negative weights count as zero.
+	//   - r: The source of randomness, or nil to use the global source.
+	//
+	// Returns:
+	//   The chosen element, and false if the slice is empty or all weights are zero.
+	WeightedChoice(weight func(T) float64, r *rand.Rand) (T, bool)
+
 	// Remove removes elements from the slice based on a predicate function.
 	//
 	// Parameters:
@@ -243,9 +287,92 @@ type IAdvancedSlice[T any] interface {
 	// RemoveAt removes an element at the specified index from the slice.
 	//
 	// Parameters:
-	//   - index: The index of the element to remove.
+	//   - index: The index of the element to remove. A negative index counts from the end.
 	//
 	// Returns:
 	//   The updated slice.
 	RemoveAt(index int) IAdvancedSlice[T]
+
+	// AtErr returns the element at the specified index in the slice.
+	// It reports an out-of-range index as an error under every bounds policy, including BoundsPanic.
+	//
+	// Parameters:
+	//   - index: The index of the element to retrieve. A negative index counts from the end.
+	//
+	// Returns:
+	//   The element at the specified index.
+	//   An error wrapping ErrIndexOutOfRange if the index is out of range and the bounds policy does not clamp it.
+	AtErr(index int) (T, error)
+
+	// RemoveAtErr removes an element at the specified index from the slice.
+	// It reports an out-of-range index as an error under every bounds policy, including BoundsPanic.
+	//
+	// Parameters:
+	//   - index: The index of the element to remove. A negative index counts from the end.
+	//
+	// Returns:
+	//   The updated slice.
+	//   An error wrapping ErrIndexOutOfRange if the index is out of range and the bounds policy does not clamp it.
+	RemoveAtErr(index int) (IAdvancedSlice[T], error)
+
+	// PopErr removes and returns the last element from the slice.
+	// It returns ErrEmpty for an empty slice under every bounds policy, including BoundsPanic.
+	//
+	// Returns:
+	//   The last element of the slice.
+	//   ErrEmpty if the slice is empty.
+	PopErr() (T, error)
+
+	// ShiftErr removes and returns the first element from the slice.
+	// It returns ErrEmpty for an empty slice under every bounds policy, including BoundsPanic.
+	//
+	// Returns:
+	//   The first element of the slice.
+	//   ErrEmpty if the slice is empty.
+	ShiftErr() (T, error)
+
+	// SliceErr is like Slice but reports invalid steps and out-of-range begin indices.
+	//
+	// Parameters:
+	//   - index: A variadic parameter specifying the begin and optionally end and step indices.
+	//
+	// Returns:
+	//   The updated slice.
+	//   An error wrapping ErrInvalidStep or ErrIndexOutOfRange; the slice is left unchanged in that case.
+	SliceErr(index ...int) (IAdvancedSlice[T], error)
+
+	// SetBoundsPolicy sets how out-of-range indices and removals from an empty slice are handled
+	// by At, RemoveAt, CopyWithIn, Fill, Pop and Shift.
+	//
+	// Parameters:
+	//   - policy: The bounds policy.
+	SetBoundsPolicy(policy BoundsPolicy)
+
+	// SetComparator sets the comparison function Sort uses when it is called with nil.
+	//
+	// Parameters:
+	//   - less: A comparison function that determines the order of elements.
+	SetComparator(less func(a, b T) bool)
+
+	// SetKey sets the key function Unique uses when it is called with nil.
+	//
+	// Parameters:
+	//   - key: A function that extracts a key from each element.
+	SetKey(key func(T) string)
+
+	// Err returns the last error recorded under the BoundsError policy and clears it.
+	//
+	// Returns:
+	//   The recorded error, or nil.
+	Err() error
+
+	// Begin starts a transaction over the slice. Changes made through the transaction
+	// become visible only when it is committed.
+	//
+	// Parameters:
+	//   - validators: Invariants checked against the transaction's elements at commit time.
+	//
+	// Returns:
+	//   The transaction.
+	Begin(validators ...Validator[T]) *Tx[T]
 }
@@ -0,0 +1,193 @@
+package slice
+
+import "iter"
+
+// mergeCursor is the read position in one of the sorted inputs of a merge.
+type mergeCursor[T any] struct {
+	head T
+	src  int
+	next func() (T, bool)
+}
+
+// mergeSorted k-way merges sorted sources into yield using a heap of their heads.
+// Ties go to the earlier source, so the merge is stable. With unique set, only the first
+// of each run of equal elements is yielded.
+func mergeSorted[T any](less func(a, b T) bool, unique bool, sources []func() (T, bool), yield func(T) bool) {
+	h := NewHeap(func(a, b *mergeCursor[T]) bool {
+		switch {
+		case less(a.head, b.head):
+			return true
+		case less(b.head, a.head):
+			return false
+		}
+		return a.src < b.src
+	})
+	for i, next := range sources {
+		if v, ok := next(); ok {
+			h.Push(&mergeCursor[T]{head: v, src: i, next: next})
+		}
+	}
+	var last T
+	emitted := false
+	for {
+		c, ok := h.Peek()
+		if !ok {
+			return
+		}
+		// The heads come out in order, so a head differs from the last one exactly when it sorts after it.
+		if !unique || !emitted || less(last, c.head) {
+			if !yield(c.head) {
+				return
+			}
+			last, emitted = c.head, true
+		}
+		if v, ok := c.next(); ok {
+			c.head = v
+			h.Fix(0)
+		} else {
+			h.Pop()
+		}
+	}
+}
+
+// sliceSources returns a source function reading each slice front to back.
+func sliceSources[T any](slices [][]T) []func() (T, bool) {
+	sources := make([]func() (T, bool), len(slices))
+	for i, s := range slices {
+		sources[i] = func() (T, bool) {
+			if len(s) == 0 {
+				var zero T
+				return zero, false
+			}
+			v := s[0]
+			s = s[1:]
+			return v, true
+		}
+	}
+	return sources
+}
+
+// collectMerge runs a merge of sorted slices into a new slice.
+func collectMerge[T any](less func(a, b T) bool, unique bool, slices [][]T) []T {
+	n := 0
+	for _, s := range slices {
+		n += len(s)
+	}
+	list := make([]T, 0, n)
+	mergeSorted(less, unique, sliceSources(slices), func(v T) bool {
+		list = append(list, v)
+		return true
+	})
+	return list
+}
+
+// MergeSorted merges slices that are each already sorted by less into one sorted slice in O(n log k),
+// where k is the number of slices. The merge is stable: equal elements keep the order of their slices.
+//
+// Parameters:
+//   - less: A function reporting whether a sorts before b, as in Sort.
+//   - slices: The sorted slices.
+//
+// Returns:
+//
+//	A new sorted slice holding every element.
+//
+// Example:
+//
+//	MergeSorted(func(a, b int) bool { return a < b }, []int{1, 4, 7}, []int{2, 4, 8}) // [1 2 4 4 7 8]
+func MergeSorted[T any](less func(a, b T) bool, slices ...[]T) []T {
+	return collectMerge(less, false, slices)
+}
+
+// MergeSortedUnique merges sorted slices like MergeSorted but keeps only the first of each group of
+// elements that neither sorts before the other, whether they come from the same slice or different ones.
+//
+// Parameters:
+//   - less: A function reporting whether a sorts before b, as in MergeSorted.
+//   - slices: The sorted slices.
+//
+// Returns:
+//
+//	A new sorted slice without duplicates.
+//
+// Example:
+//
+//	MergeSortedUnique(func(a, b int) bool { return a < b }, []int{1, 4, 7}, []int{2, 4, 8}) // [1 2 4 7 8]
+func MergeSortedUnique[T any](less func(a, b T) bool, slices ...[]T) []T {
+	return collectMerge(less, true, slices)
+}
+
+// MergeSortedSeq lazily merges sequences that are each already sorted by less.
+// Each sequence is consumed one element at a time, so the inputs may be unbounded or expensive to produce.
+//
+// Parameters:
+//   - less: A function reporting whether a sorts before b, as in MergeSorted.
+//   - seqs: The sorted sequences.
+//
+// Returns:
+//
+//   - iter.Seq[T]: The merged sequence.
+func MergeSortedSeq[T any](less func(a, b T) bool, seqs ...iter.Seq[T]) iter.Seq[T] {
+	return mergeSortedSeq(less, false, seqs)
+}
+
+// MergeSortedUniqueSeq lazily merges sorted sequences like MergeSortedSeq, dropping duplicates as MergeSortedUnique does.
+//
+// Parameters:
+//   - less: A function reporting whether a sorts before b, as in MergeSorted.
+//   - seqs: The sorted sequences.
+//
+// Returns:
+//
+//   - iter.Seq[T]: The merged sequence without duplicates.
+func MergeSortedUniqueSeq[T any](less func(a, b T) bool, seqs ...iter.Seq[T]) iter.Seq[T] {
+	return mergeSortedSeq(less, true, seqs)
+}
+
+// mergeSortedSeq pulls from each sequence and merges them, stopping every puller when iteration ends.
+func mergeSortedSeq[T any](less func(a, b T) bool, unique bool, seqs []iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		sources := make([]func() (T, bool), len(seqs))
+		for i, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			defer stop()
+			sources[i] = next
+		}
+		mergeSorted(less, unique, sources, yield)
+	}
+}
+
+// MergeSortedSlices merges advanced slices whose elements are each already sorted by less into a new
+// advanced slice. The inputs are left unchanged.
+//
+// Parameters:
+//   - less: A function reporting whether a sorts before b, as in MergeSorted.
+//   - ss: The sorted advanced slices.
+//
+// Returns:
+//
+//   - IAdvancedSlice[T]: A new advanced slice holding every element in sorted order.
+func MergeSortedSlices[T any](less func(a, b T) bool, ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	slices := make([][]T, len(ss))
+	for i, s := range ss {
+		slices[i] = s.Values()
+	}
+	return NewAdvancedSlice(MergeSorted(less, slices...)...)
+}
+
+// MergeSortedUniqueSlices merges sorted advanced slices like MergeSortedSlices, dropping duplicates as MergeSortedUnique does.
+//
+// Parameters:
+//   - less: A function reporting whether a sorts before b, as in MergeSorted.
+//   - ss: The sorted advanced slices.
+//
+// Returns:
+//
+//   - IAdvancedSlice[T]: A new advanced slice holding the distinct elements in sorted order.
+func MergeSortedUniqueSlices[T any](less func(a, b T) bool, ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	slices := make([][]T, len(ss))
+	for i, s := range ss {
+		slices[i] = s.Values()
+	}
+	return NewAdvancedSlice(MergeSortedUnique(less, slices...)...)
+}
@@ -0,0 +1,132 @@
+package slice_test
+
+import (
+	"iter"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		name       string
+		in         [][]int
+		want       []int
+		wantUnique []int
+	}{
+		{"none", nil, []int{}, []int{}},
+		{"empty inputs", [][]int{{}, nil, {}}, []int{}, []int{}},
+		{"single", [][]int{{1, 1, 2}}, []int{1, 1, 2}, []int{1, 2}},
+		{"interleaved", [][]int{{1, 4, 7}, {2, 4, 8}, {0, 9}}, []int{0, 1, 2, 4, 4, 7, 8, 9}, []int{0, 1, 2, 4, 7, 8, 9}},
+		{"disjoint", [][]int{{5, 6}, {1, 2}}, []int{1, 2, 5, 6}, []int{1, 2, 5, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slice.MergeSorted(intLess, tt.in...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeSorted() = %v, want %v", got, tt.want)
+			}
+			if got := slice.MergeSortedUnique(intLess, tt.in...); !reflect.DeepEqual(got, tt.wantUnique) {
+				t.Errorf("MergeSortedUnique() = %v, want %v", got, tt.wantUnique)
+			}
+			seqs := make([]iter.Seq[int], len(tt.in))
+			for i, s := range tt.in {
+				seqs[i] = slices.Values(s)
+			}
+			if got := slices.Collect(slice.MergeSortedSeq(intLess, seqs...)); !slices.Equal(got, tt.want) {
+				t.Errorf("MergeSortedSeq() = %v, want %v", got, tt.want)
+			}
+			if got := slices.Collect(slice.MergeSortedUniqueSeq(intLess, seqs...)); !slices.Equal(got, tt.wantUnique) {
+				t.Errorf("MergeSortedUniqueSeq() = %v, want %v", got, tt.wantUnique)
+			}
+		})
+	}
+}
+
+func TestMergeSortedStable(t *testing.T) {
+	type hit struct {
+		Score int
+		Shard string
+	}
+	byScore := func(a, b hit) bool { return a.Score < b.Score }
+	got := slice.MergeSorted(byScore,
+		[]hit{{1, "a"}, {3, "a"}},
+		[]hit{{1, "b"}, {3, "b"}},
+	)
+	want := []hit{{1, "a"}, {1, "b"}, {3, "a"}, {3, "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeSorted() = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSortedSeqEarlyStop(t *testing.T) {
+	pulled := 0
+	naturals := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	var got []int
+	for v := range slice.MergeSortedSeq(intLess, naturals, slices.Values([]int{2, 3})) {
+		got = append(got, v)
+		if len(got) == 5 {
+			break
+		}
+	}
+	if want := []int{0, 1, 2, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("MergeSortedSeq() = %v, want %v", got, want)
+	}
+	if pulled > 6 {
+		t.Errorf("pulled %d elements from an unbounded input, want it consumed lazily", pulled)
+	}
+}
+
+func TestMergeSortedSlices(t *testing.T) {
+	a := slice.NewAdvancedSlice(1, 3, 5)
+	b := slice.NewAdvancedSlice(2, 3, 6)
+	if got, want := slice.MergeSortedSlices(intLess, a, b).Values(), []int{1, 2, 3, 3, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeSortedSlices() = %v, want %v", got, want)
+	}
+	if got, want := slice.MergeSortedUniqueSlices(intLess, a, b).Values(), []int{1, 2, 3, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeSortedUniqueSlices() = %v, want %v", got, want)
+	}
+	if got, want := a.Values(), []int{1, 3, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("input changed: %v", got)
+	}
+}
+
+// shards returns k sorted slices holding n random elements between them.
+func shards(k, n int) [][]int {
+	r := rand.New(rand.NewPCG(5, 6))
+	out := make([][]int, k)
+	for i := range n {
+		out[i%k] = append(out[i%k], r.IntN(n))
+	}
+	for _, s := range out {
+		slices.Sort(s)
+	}
+	return out
+}
+
+func BenchmarkMergeSorted(b *testing.B) {
+	in := shards(16, 1<<16)
+	for b.Loop() {
+		slice.MergeSorted(intLess, in...)
+	}
+}
+
+func BenchmarkConcatSort(b *testing.B) {
+	in := shards(16, 1<<16)
+	for b.Loop() {
+		var all []int
+		for _, s := range in {
+			all = append(all, s...)
+		}
+		slice.Sort(all, func(a, b int) bool { return a < b })
+	}
+}
@@ -0,0 +1,285 @@
+package slice
+
+import (
+	"cmp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NaturalCompare compares two strings in natural order: runs of decimal digits are compared by their
+// numeric value, so "node2" sorts before "node10", and everything else rune by rune.
+// Digits from any script count, "file٣" and "file3" compare as equal numbers, and runs of any length
+// are handled without overflow. When two strings differ only in leading zeros, the one with fewer zeros
+// comes first; any remaining tie is broken by comparing the strings byte-wise, so the order is total.
+//
+// Parameters:
+//   - a: The first string.
+//   - b: The second string.
+//
+// Returns:
+//
+//	A negative number if a sorts before b, a positive number if it sorts after, or zero if a == b.
+//
+// Example:
+//
+//	NaturalCompare("img12.png", "img2.png") // 1
+func NaturalCompare(a, b string) int {
+	origA, origB := a, b
+	tie := 0
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if digitValue(ra) >= 0 && digitValue(rb) >= 0 {
+			var runA, runB string
+			runA, a = digitRun(a)
+			runB, b = digitRun(b)
+			sigA, zerosA := trimZeros(runA)
+			sigB, zerosB := trimZeros(runB)
+			if c := compareDigits(sigA, sigB); c != 0 {
+				return c
+			}
+			if tie == 0 {
+				tie = cmp.Compare(zerosA, zerosB)
+			}
+			continue
+		}
+		if ra != rb {
+			return cmp.Compare(ra, rb)
+		}
+		a, b = a[na:], b[nb:]
+	}
+	switch {
+	case a != "":
+		return 1
+	case b != "":
+		return -1
+	case tie != 0:
+		return tie
+	}
+	return strings.Compare(origA, origB)
+}
+
+// NaturalLess reports whether a sorts before b in natural order. It can be passed directly to Sort
+// and IAdvancedSlice.Sort.
+//
+// Parameters:
+//   - a: The first string.
+//   - b: The second string.
+//
+// Returns:
+//
+//	true if a sorts before b, false otherwise.
+//
+// Example:
+//
+//	Sort([]string{"node10", "node2", "node1"}, NaturalLess) // ["node1", "node2", "node10"]
+func NaturalLess(a, b string) bool {
+	return NaturalCompare(a, b) < 0
+}
+
+// digitValue returns the value of a Unicode decimal digit, or -1 if r is not one.
+// Decimal digits are encoded in contiguous blocks running from 0 to 9, so the value is the offset
+// into the block.
+func digitValue(r rune) int {
+	if '0' <= r && r <= '9' {
+		return int(r - '0')
+	}
+	if r < utf8.RuneSelf || !unicode.IsDigit(r) {
+		return -1
+	}
+	for _, rg := range unicode.Nd.R16 {
+		if rune(rg.Lo) <= r && r <= rune(rg.Hi) {
+			return int(r-rune(rg.Lo)) % 10
+		}
+	}
+	for _, rg := range unicode.Nd.R32 {
+		if rune(rg.Lo) <= r && r <= rune(rg.Hi) {
+			return int(r-rune(rg.Lo)) % 10
+		}
+	}
+	return -1
+}
+
+// digitRun splits s into its leading run of decimal digits and the rest.
+func digitRun(s string) (string, string) {
+	i := 0
+	for i < len(s) {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if digitValue(r) < 0 {
+			break
+		}
+		i += n
+	}
+	return s[:i], s[i:]
+}
+
+// trimZeros removes the leading zero digits of a digit run and returns what is left and how many were removed.
+func trimZeros(run string) (string, int) {
+	zeros := 0
+	for run != "" {
+		r, n := utf8.DecodeRuneInString(run)
+		if digitValue(r) != 0 {
+			break
+		}
+		run = run[n:]
+		zeros++
+	}
+	return run, zeros
+}
+
+// compareDigits compares two digit runs without leading zeros by numeric value.
+func compareDigits(a, b string) int {
+	if c := cmp.Compare(utf8.RuneCountInString(a), utf8.RuneCountInString(b)); c != 0 {
+		return c
+	}
+	for a != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if c := cmp.Compare(digitValue(ra), digitValue(rb)); c != 0 {
+			return c
+		}
+		a, b = a[na:], b[nb:]
+	}
+	return 0
+}
+
+// semver is a parsed semantic version. The numbers are kept as digit strings so that they cannot overflow.
+type semver struct {
+	core       [3]string
+	prerelease []string
+}
+
+// parseSemver parses a semantic version, accepting an optional "v" prefix, missing minor or patch
+// numbers and leading zeros. Build metadata after "+" is ignored.
+func parseSemver(s string) (semver, bool) {
+	var v semver
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "v"), "V")
+	s, _, _ = strings.Cut(s, "+")
+	s, pre, hasPre := strings.Cut(s, "-")
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return v, false
+	}
+	for i := range v.core {
+		v.core[i] = "0"
+		if i >= len(parts) {
+			continue
+		}
+		if !isASCIIDigits(parts[i]) {
+			return v, false
+		}
+		if v.core[i] = strings.TrimLeft(parts[i], "0"); v.core[i] == "" {
+			v.core[i] = "0"
+		}
+	}
+	if hasPre {
+		v.prerelease = strings.Split(pre, ".")
+		for _, id := range v.prerelease {
+			if id == "" {
+				return v, false
+			}
+		}
+	}
+	return v, true
+}
+
+// isASCIIDigits reports whether s is a non-empty string of ASCII digits.
+func isASCIIDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// comparePrerelease compares pre-release identifiers by semantic version precedence.
+func comparePrerelease(a, b []string) int {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+	for i := range min(len(a), len(b)) {
+		x, y := a[i], b[i]
+		nx, ny := isASCIIDigits(x), isASCIIDigits(y)
+		var c int
+		switch {
+		case nx && ny:
+			c = compareDigits(strings.TrimLeft(x, "0"), strings.TrimLeft(y, "0"))
+		case nx:
+			c = -1
+		case ny:
+			c = 1
+		default:
+			c = strings.Compare(x, y)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+// CompareSemver compares two semantic versions by precedence as defined by Semantic Versioning 2.0.0:
+// major, minor and patch numerically, then a version with a pre-release tag before the same version
+// without one, with pre-release identifiers compared numerically or lexically as the specification says.
+// It is lenient about a leading "v", missing minor or patch numbers and leading zeros, and ignores build
+// metadata. Strings that are not versions sort after every version, in natural order among themselves.
+//
+// Parameters:
+//   - a: The first version.
+//   - b: The second version.
+//
+// Returns:
+//
+//	A negative number if a has lower precedence than b, a positive number if higher, or zero if equal.
+//
+// Example:
+//
+//	CompareSemver("1.0.0-rc.1", "1.0.0") // -1
+//	CompareSemver("v1.10.0", "1.9.3")    // 1
+func CompareSemver(a, b string) int {
+	va, okA := parseSemver(a)
+	vb, okB := parseSemver(b)
+	switch {
+	case !okA && !okB:
+		return NaturalCompare(a, b)
+	case !okA:
+		return 1
+	case !okB:
+		return -1
+	}
+	for i := range va.core {
+		if c := compareDigits(va.core[i], vb.core[i]); c != 0 {
+			return c
+		}
+	}
+	return comparePrerelease(va.prerelease, vb.prerelease)
+}
+
+// SemverLess reports whether version a has lower precedence than version b. It can be passed directly
+// to Sort and IAdvancedSlice.Sort; because versions of equal precedence compare as equal, use a stable
+// sort if their relative order matters.
+//
+// Parameters:
+//   - a: The first version.
+//   - b: The second version.
+//
+// Returns:
+//
+//	true if a sorts before b, false otherwise.
+//
+// Example:
+//
+//	Sort([]string{"1.10.0", "1.2.0", "1.2.0-beta"}, SemverLess) // ["1.2.0-beta", "1.2.0", "1.10.0"]
+func SemverLess(a, b string) bool {
+	return CompareSemver(a, b) < 0
+}
@@ -0,0 +1,213 @@
+package slice_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+// sign reduces a comparison result to -1, 0 or 1.
+func sign(c int) int {
+	switch {
+	case c < 0:
+		return -1
+	case c > 0:
+		return 1
+	}
+	return 0
+}
+
+func TestNaturalCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		// Plain text.
+		{"", "", 0},
+		{"", "a", -1},
+		{"a", "a", 0},
+		{"a", "b", -1},
+		{"abc", "abd", -1},
+		{"ab", "abc", -1},
+		{"B", "a", -1},
+		{"é", "e", 1},
+
+		// Numbers.
+		{"1", "2", -1},
+		{"2", "10", -1},
+		{"10", "10", 0},
+		{"9", "10", -1},
+		{"99", "100", -1},
+		{"123456789012345678901234567890", "123456789012345678901234567891", -1},
+		{"99999999999999999999999999", "100000000000000000000000000", -1},
+
+		// Mixed text and numbers.
+		{"node2", "node10", -1},
+		{"node10", "node2", 1},
+		{"node1", "node1a", -1},
+		{"node1a", "node2", -1},
+		{"file9.txt", "file10.txt", -1},
+		{"img12.png", "img2.png", 1},
+		{"a1b2c3", "a1b2c10", -1},
+		{"a1b10", "a2b1", -1},
+		{"x2-g8", "x2-y08", -1},
+		{"x2-y08", "x2-y7", 1},
+		{"x8-y8", "x10-y2", -1},
+		{"1a", "a", -1},
+		{"a", "1a", 1},
+		{"abc2", "abc", 1},
+		{"rack1-host3", "rack1-host12", -1},
+		{"rack2-host1", "rack10-host1", -1},
+		{"v1.2.10", "v1.2.9", 1},
+		{"1.010", "1.01", 1},
+
+		// Leading zeros.
+		{"01", "1", 1},
+		{"1", "01", -1},
+		{"001", "01", 1},
+		{"0", "00", -1},
+		{"a01", "a1", 1},
+		{"a01b", "a1c", -1},
+		{"a001b2", "a01b3", -1},
+		{"007", "7", 1},
+		{"007", "8", -1},
+		{"0010", "9", 1},
+		{"00", "000", -1},
+
+		// Unicode digits.
+		{"file٣", "file3", 1},
+		{"file٣", "file4", -1},
+		{"file١٠", "file9", 1},
+		{"٢", "١٠", -1},
+		{"part５", "part１０", -1},
+		{"ch०९", "ch१०", -1},
+		{"ch๙", "ch๑๐", -1},
+
+		// Separators and punctuation.
+		{"a b", "a-b", -1},
+		{"a_1", "a_01", -1},
+		{"a.1", "a.10", -1},
+		{"host-1.example.com", "host-10.example.com", -1},
+		{"host-2.example.com", "host-10.example.com", -1},
+		{"host-2.example.com", "host-2.example.org", -1},
+	}
+	for _, tt := range tests {
+		if got := sign(slice.NaturalCompare(tt.a, tt.b)); got != tt.want {
+			t.Errorf("NaturalCompare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := sign(slice.NaturalCompare(tt.b, tt.a)); got != -tt.want {
+			t.Errorf("NaturalCompare(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+		if got := slice.NaturalLess(tt.a, tt.b); got != (tt.want < 0) {
+			t.Errorf("NaturalLess(%q, %q) = %v", tt.a, tt.b, got)
+		}
+	}
+}
+
+func TestCompareSemver(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		// Core numbers.
+		{"1.0.0", "1.0.0", 0},
+		{"1.0.0", "2.0.0", -1},
+		{"2.0.0", "2.1.0", -1},
+		{"2.1.0", "2.1.1", -1},
+		{"1.9.0", "1.10.0", -1},
+		{"1.10.0", "1.9.99", 1},
+		{"0.0.9", "0.0.10", -1},
+		{"10.0.0", "9.99.99", 1},
+		{"1.0.99999999999999999999", "1.0.100000000000000000000", -1},
+
+		// Lenient forms.
+		{"v1.2.3", "1.2.3", 0},
+		{"V1.2.3", "v1.2.3", 0},
+		{"1.2", "1.2.0", 0},
+		{"1", "1.0.0", 0},
+		{"1.2", "1.2.1", -1},
+		{"01.002.0003", "1.2.3", 0},
+		{"1.02.0", "1.10.0", -1},
+
+		// Pre-release tags, from the Semantic Versioning 2.0.0 precedence example.
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-alpha.beta", "1.0.0-beta", -1},
+		{"1.0.0-beta", "1.0.0-beta.2", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-beta.11", "1.0.0-rc.1", -1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+
+		// More pre-release cases.
+		{"1.0.0-rc.1", "1.0.0-rc.01", 0},
+		{"1.0.0-1", "1.0.0-alpha", -1},
+		{"1.0.0-2", "1.0.0-10", -1},
+		{"1.0.0-rc-1", "1.0.0-rc-2", -1},
+		{"1.0.0-RC.1", "1.0.0-rc.1", -1},
+		{"1.0.0-rc.1", "0.9.9", 1},
+		{"2.0.0-alpha", "1.99.99", 1},
+
+		// Build metadata is ignored.
+		{"1.0.0+build.1", "1.0.0+build.2", 0},
+		{"1.0.0-rc.1+build.5", "1.0.0-rc.1", 0},
+		{"1.0.0+20130313144700", "1.0.1", -1},
+
+		// Invalid versions sort last, in natural order.
+		{"1.0.0", "latest", -1},
+		{"latest", "1.0.0", 1},
+		{"1.2.3.4", "9.9.9", 1},
+		{"1.x", "1.0.0", 1},
+		{"", "0.0.0", 1},
+		{"1.0.0-", "1.0.0", 1},
+		{"1.0.0-a..b", "1.0.0", 1},
+		{"nightly2", "nightly10", -1},
+	}
+	for _, tt := range tests {
+		if got := sign(slice.CompareSemver(tt.a, tt.b)); got != tt.want {
+			t.Errorf("CompareSemver(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := sign(slice.CompareSemver(tt.b, tt.a)); got != -tt.want {
+			t.Errorf("CompareSemver(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+		if got := slice.SemverLess(tt.a, tt.b); got != (tt.want < 0) {
+			t.Errorf("SemverLess(%q, %q) = %v", tt.a, tt.b, got)
+		}
+	}
+}
+
+func TestNaturalSort(t *testing.T) {
+	tests := []struct {
+		name string
+		less func(a, b string) bool
+		want []string
+	}{
+		{"file names", slice.NaturalLess, []string{
+			"file1.txt", "file2.txt", "file02.txt", "file3.txt", "file10.txt", "file10a.txt", "file11.txt", "file100.txt",
+		}},
+		{"hostnames", slice.NaturalLess, []string{
+			"db1", "db2", "db10", "node1", "node2", "node9", "node10", "node11", "node100", "web1",
+		}},
+		{"versions", slice.SemverLess, []string{
+			"0.9.0", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+			"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.2.0", "1.10.0", "2.0.0", "banana", "nightly",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewPCG(11, 12))
+			for range 20 {
+				shuffled := slices.Clone(tt.want)
+				r.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+				if got := slice.Sort(slices.Clone(shuffled), tt.less); !slices.Equal(got, tt.want) {
+					t.Fatalf("Sort() = %v, want %v", got, tt.want)
+				}
+				s := slice.NewAdvancedSlice(shuffled...)
+				if got := s.Sort(tt.less).Values(); !slices.Equal(got, tt.want) {
+					t.Fatalf("IAdvancedSlice.Sort() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
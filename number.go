@@ -0,0 +1,457 @@
+package slice
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// zipWith combines two slices of equal length element by element into a new slice.
+func zipWith[T Number](a, b []T, f func(x, y T) T) ([]T, error) {
+	if len(a) != len(b) {
+		return nil, lengthError(len(a), len(b))
+	}
+	return Map(a, func(x T, i int) T { return f(x, b[i]) }), nil
+}
+
+// Add returns the element-wise sum of two slices.
+//
+// Parameters:
+//   - a: The first slice.
+//   - b: The second slice, of the same length.
+//
+// Returns:
+//
+//	A new slice with a[i] + b[i], and an error wrapping ErrLengthMismatch if the lengths differ.
+func Add[T Number](a, b []T) ([]T, error) {
+	return zipWith(a, b, func(x, y T) T { return x + y })
+}
+
+// Sub returns the element-wise difference of two slices.
+//
+// Parameters:
+//   - a: The first slice.
+//   - b: The second slice, of the same length.
+//
+// Returns:
+//
+//	A new slice with a[i] - b[i], and an error wrapping ErrLengthMismatch if the lengths differ.
+func Sub[T Number](a, b []T) ([]T, error) {
+	return zipWith(a, b, func(x, y T) T { return x - y })
+}
+
+// Mul returns the element-wise product of two slices.
+//
+// Parameters:
+//   - a: The first slice.
+//   - b: The second slice, of the same length.
+//
+// Returns:
+//
+//	A new slice with a[i] * b[i], and an error wrapping ErrLengthMismatch if the lengths differ.
+func Mul[T Number](a, b []T) ([]T, error) {
+	return zipWith(a, b, func(x, y T) T { return x * y })
+}
+
+// Div returns the element-wise quotient of two slices.
+//
+// Parameters:
+//   - a: The dividend slice.
+//   - b: The divisor slice, of the same length.
+//
+// Returns:
+//
+//	A new slice with a[i] / b[i], and an error wrapping ErrLengthMismatch if the lengths differ
+//	or ErrDivisionByZero if any divisor is zero.
+func Div[T Number](a, b []T) ([]T, error) {
+	if len(a) != len(b) {
+		return nil, lengthError(len(a), len(b))
+	}
+	if FindIndex(b, func(v T) bool { return v == 0 }) >= 0 {
+		return nil, ErrDivisionByZero
+	}
+	return zipWith(a, b, func(x, y T) T { return x / y })
+}
+
+// AddScalar returns a new slice with v added to every element.
+//
+// Parameters:
+//   - s: The original slice.
+//   - v: The value to add.
+//
+// Returns:
+//
+//	A new slice with s[i] + v.
+func AddScalar[T Number](s []T, v T) []T {
+	return Map(s, func(x T, _ int) T { return x + v })
+}
+
+// SubScalar returns a new slice with v subtracted from every element.
+//
+// Parameters:
+//   - s: The original slice.
+//   - v: The value to subtract.
+//
+// Returns:
+//
+//	A new slice with s[i] - v.
+func SubScalar[T Number](s []T, v T) []T {
+	return Map(s, func(x T, _ int) T { return x - v })
+}
+
+// MulScalar returns a new slice with every element multiplied by v.
+//
+// Parameters:
+//   - s: The original slice.
+//   - v: The multiplier.
+//
+// Returns:
+//
+//	A new slice with s[i] * v.
+func MulScalar[T Number](s []T, v T) []T {
+	return Map(s, func(x T, _ int) T { return x * v })
+}
+
+// DivScalar returns a new slice with every element divided by v.
+//
+// Parameters:
+//   - s: The original slice.
+//   - v: The divisor.
+//
+// Returns:
+//
+//	A new slice with s[i] / v, and ErrDivisionByZero if v is zero.
+func DivScalar[T Number](s []T, v T) ([]T, error) {
+	if v == 0 {
+		return nil, ErrDivisionByZero
+	}
+	return Map(s, func(x T, _ int) T { return x / v }), nil
+}
+
+// Dot returns the dot product of two slices.
+//
+// Parameters:
+//   - a: The first slice.
+//   - b: The second slice, of the same length.
+//
+// Returns:
+//
+//	The sum of a[i] * b[i], and an error wrapping ErrLengthMismatch if the lengths differ.
+func Dot[T Number](a, b []T) (T, error) {
+	var sum T
+	if len(a) != len(b) {
+		return sum, lengthError(len(a), len(b))
+	}
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum, nil
+}
+
+// Norm returns the Euclidean length of the slice as a vector.
+//
+// Parameters:
+//   - s: The slice.
+//
+// Returns:
+//
+//	The square root of the sum of squares, computed in float64.
+func Norm[T Number](s []T) float64 {
+	var sum float64
+	for _, v := range s {
+		f := float64(v)
+		sum += f * f
+	}
+	return math.Sqrt(sum)
+}
+
+// Normalize returns the slice scaled to unit Euclidean length.
+//
+// Parameters:
+//   - s: The slice.
+//
+// Returns:
+//
+//	A new slice of float64 with s[i] / Norm(s), or all zeros if the norm is zero.
+func Normalize[T Number](s []T) []float64 {
+	n := Norm(s)
+	return Map(s, func(v T, _ int) float64 {
+		if n == 0 {
+			return 0
+		}
+		return float64(v) / n
+	})
+}
+
+// CumSum returns the running totals of the slice.
+//
+// Parameters:
+//   - s: The slice.
+//
+// Returns:
+//
+//	A new slice where element i is the sum of s[0] through s[i].
+func CumSum[T Number](s []T) []T {
+	var sum T
+	return Map(s, func(v T, _ int) T {
+		sum += v
+		return sum
+	})
+}
+
+// Diff returns the differences between successive elements.
+//
+// Parameters:
+//   - s: The slice.
+//
+// Returns:
+//
+//	A new slice of length len(s)-1 with s[i+1] - s[i], or an empty slice if s has fewer than two elements.
+func Diff[T Number](s []T) []T {
+	if len(s) < 2 {
+		return []T{}
+	}
+	return Map(s[1:], func(v T, i int) T { return v - s[i] })
+}
+
+var _ IAdvancedSlice[int] = (*NumberSlice[int])(nil)
+
+// NumberSlice is an advanced slice of numbers with element-wise vector arithmetic.
+// Arithmetic methods update the slice in place, like Map; on error the slice is left unchanged.
+// It also provides every OrderedSlice operation.
+type NumberSlice[T Number] struct {
+	*OrderedSlice[T]
+}
+
+// NewNumberSlice creates a new number slice.
+//
+// Parameters:
+//   - data: The initial elements.
+//
+// Returns:
+//
+//   - *NumberSlice[T]: The number slice.
+//
+// Example:
+//
+//	v := NewNumberSlice(1.0, 2.0, 3.0)
+//	v.MulScalar(2)                         // [2 4 6]
+//	_, err := v.Add(NewAdvancedSlice(1.0)) // ErrLengthMismatch
+func NewNumberSlice[T Number](data ...T) *NumberSlice[T] {
+	return &NumberSlice[T]{OrderedSlice: NewOrderedSlice(data...)}
+}
+
+// apply replaces the elements with the result of an element-wise operation, unless it failed.
+func (s *NumberSlice[T]) apply(data []T, err error) (IAdvancedSlice[T], error) {
+	if err != nil {
+		return s, err
+	}
+	s.data = data
+	return s, nil
+}
+
+// Add adds the elements of other element-wise, or returns an error wrapping ErrLengthMismatch.
+func (s *NumberSlice[T]) Add(other IAdvancedSlice[T]) (IAdvancedSlice[T], error) {
+	return s.apply(Add(s.data, other.Values()))
+}
+
+// Sub subtracts the elements of other element-wise, or returns an error wrapping ErrLengthMismatch.
+func (s *NumberSlice[T]) Sub(other IAdvancedSlice[T]) (IAdvancedSlice[T], error) {
+	return s.apply(Sub(s.data, other.Values()))
+}
+
+// Mul multiplies by the elements of other element-wise, or returns an error wrapping ErrLengthMismatch.
+func (s *NumberSlice[T]) Mul(other IAdvancedSlice[T]) (IAdvancedSlice[T], error) {
+	return s.apply(Mul(s.data, other.Values()))
+}
+
+// Div divides by the elements of other element-wise, or returns an error wrapping ErrLengthMismatch or ErrDivisionByZero.
+func (s *NumberSlice[T]) Div(other IAdvancedSlice[T]) (IAdvancedSlice[T], error) {
+	return s.apply(Div(s.data, other.Values()))
+}
+
+// AddScalar adds v to every element.
+func (s *NumberSlice[T]) AddScalar(v T) IAdvancedSlice[T] {
+	s.data = AddScalar(s.data, v)
+	return s
+}
+
+// SubScalar subtracts v from every element.
+func (s *NumberSlice[T]) SubScalar(v T) IAdvancedSlice[T] {
+	s.data = SubScalar(s.data, v)
+	return s
+}
+
+// MulScalar multiplies every element by v.
+func (s *NumberSlice[T]) MulScalar(v T) IAdvancedSlice[T] {
+	s.data = MulScalar(s.data, v)
+	return s
+}
+
+// DivScalar divides every element by v, or returns ErrDivisionByZero.
+func (s *NumberSlice[T]) DivScalar(v T) (IAdvancedSlice[T], error) {
+	return s.apply(DivScalar(s.data, v))
+}
+
+// Dot returns the dot product with other, or an error wrapping ErrLengthMismatch.
+func (s *NumberSlice[T]) Dot(other IAdvancedSlice[T]) (T, error) {
+	return Dot(s.data, other.Values())
+}
+
+// Norm returns the Euclidean length of the slice.
+func (s *NumberSlice[T]) Norm() float64 {
+	return Norm(s.data)
+}
+
+// Normalize returns the slice scaled to unit length as a new float64 slice.
+func (s *NumberSlice[T]) Normalize() []float64 {
+	return Normalize(s.data)
+}
+
+// CumSum replaces the elements with their running totals.
+func (s *NumberSlice[T]) CumSum() IAdvancedSlice[T] {
+	s.data = CumSum(s.data)
+	return s
+}
+
+// Diff replaces the elements with the differences between successive elements, shortening the slice by one.
+func (s *NumberSlice[T]) Diff() IAdvancedSlice[T] {
+	s.data = Diff(s.data)
+	return s
+}
+
+// Clip limits every element to the range [lo, hi].
+func (s *NumberSlice[T]) Clip(lo, hi T) IAdvancedSlice[T] {
+	s.data = Clamp(s.data, lo, hi)
+	return s
+}
+
+// Clamp limits every element to the range [lo, hi].
+func (s *NumberSlice[T]) Clamp(lo, hi T) IAdvancedSlice[T] {
+	return s.Clip(lo, hi)
+}
+
+// Sort sorts the elements using f, or in ascending natural order if f is nil.
+func (s *NumberSlice[T]) Sort(f func(T, T) bool) IAdvancedSlice[T] {
+	s.OrderedSlice.Sort(f)
+	return s
+}
+
+// SortDesc sorts the elements in descending natural order.
+func (s *NumberSlice[T]) SortDesc() IAdvancedSlice[T] {
+	s.OrderedSlice.SortDesc()
+	return s
+}
+
+// Unique keeps the first occurrence of each key returned by f, or of each value if f is nil.
+func (s *NumberSlice[T]) Unique(f func(T) string) IAdvancedSlice[T] {
+	s.ComparableSlice.Unique(f)
+	return s
+}
+
+// Replace replaces every occurrence of old with new.
+func (s *NumberSlice[T]) Replace(old, new T) IAdvancedSlice[T] {
+	s.ComparableSlice.Replace(old, new)
+	return s
+}
+
+// Map replaces each element with the result of f.
+func (s *NumberSlice[T]) Map(f func(T, int) T) IAdvancedSlice[T] {
+	s.advancedSlice.Map(f)
+	return s
+}
+
+// Concat appends the elements of the given slices.
+func (s *NumberSlice[T]) Concat(ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	s.advancedSlice.Concat(ss...)
+	return s
+}
+
+// CopyWithIn keeps only the elements at the given indices.
+func (s *NumberSlice[T]) CopyWithIn(indexes ...int) IAdvancedSlice[T] {
+	s.advancedSlice.CopyWithIn(indexes...)
+	return s
+}
+
+// Slice keeps only the selected subset.
+func (s *NumberSlice[T]) Slice(index ...int) IAdvancedSlice[T] {
+	s.advancedSlice.Slice(index...)
+	return s
+}
+
+// Fill sets the selected elements to value.
+func (s *NumberSlice[T]) Fill(value T, index ...int) IAdvancedSlice[T] {
+	s.advancedSlice.Fill(value, index...)
+	return s
+}
+
+// Push appends one or more elements.
+func (s *NumberSlice[T]) Push(values ...T) IAdvancedSlice[T] {
+	s.advancedSlice.Push(values...)
+	return s
+}
+
+// PushSlice appends the elements of the given slices.
+func (s *NumberSlice[T]) PushSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	s.advancedSlice.PushSlice(values...)
+	return s
+}
+
+// Unshift prepends one or more elements.
+func (s *NumberSlice[T]) Unshift(values ...T) IAdvancedSlice[T] {
+	s.advancedSlice.Unshift(values...)
+	return s
+}
+
+// UnshiftSlice prepends the elements of each given slice in turn.
+func (s *NumberSlice[T]) UnshiftSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	s.advancedSlice.UnshiftSlice(values...)
+	return s
+}
+
+// Reverse reverses the order of the elements.
+func (s *NumberSlice[T]) Reverse() IAdvancedSlice[T] {
+	s.advancedSlice.Reverse()
+	return s
+}
+
+// Shuffle randomly permutes the elements in place.
+func (s *NumberSlice[T]) Shuffle(r *rand.Rand) IAdvancedSlice[T] {
+	s.advancedSlice.Shuffle(r)
+	return s
+}
+
+// Remove removes the elements that satisfy f.
+func (s *NumberSlice[T]) Remove(f func(T, int) bool) IAdvancedSlice[T] {
+	s.advancedSlice.Remove(f)
+	return s
+}
+
+// RemoveAt removes the element at index.
+func (s *NumberSlice[T]) RemoveAt(index int) IAdvancedSlice[T] {
+	s.advancedSlice.RemoveAt(index)
+	return s
+}
+
+// RemoveAtErr removes the element at index, or returns an error as RemoveAtErr does.
+func (s *NumberSlice[T]) RemoveAtErr(index int) (IAdvancedSlice[T], error) {
+	_, err := s.advancedSlice.RemoveAtErr(index)
+	return s, err
+}
+
+// SliceErr keeps only the selected subset, or returns an error as SliceErr does.
+func (s *NumberSlice[T]) SliceErr(index ...int) (IAdvancedSlice[T], error) {
+	_, err := s.advancedSlice.SliceErr(index...)
+	return s, err
+}
+
+// Begin starts a transaction over the slice.
+func (s *NumberSlice[T]) Begin(validators ...Validator[T]) *Tx[T] {
+	return Begin[T](s, validators...)
+}
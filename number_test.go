@@ -0,0 +1,116 @@
+package slice_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestElementWise(t *testing.T) {
+	a, b := []int64{6, 8, 10}, []int64{3, 2, 5}
+	tests := []struct {
+		name string
+		f    func(a, b []int64) ([]int64, error)
+		want []int64
+	}{
+		{"Add", slice.Add[int64], []int64{9, 10, 15}},
+		{"Sub", slice.Sub[int64], []int64{3, 6, 5}},
+		{"Mul", slice.Mul[int64], []int64{18, 16, 50}},
+		{"Div", slice.Div[int64], []int64{2, 4, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f(a, b)
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s() = %v, %v, want %v", tt.name, got, err, tt.want)
+			}
+			if _, err := tt.f(a, b[:2]); !errors.Is(err, slice.ErrLengthMismatch) {
+				t.Errorf("%s() with mismatched lengths error = %v", tt.name, err)
+			}
+		})
+	}
+	if _, err := slice.Div(a, []int64{1, 0, 1}); !errors.Is(err, slice.ErrDivisionByZero) {
+		t.Errorf("Div() by zero error = %v", err)
+	}
+	if _, err := slice.Div(a, []int64{1, 0}); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("Div() with mismatched lengths and a zero divisor error = %v", err)
+	}
+}
+
+func TestScalar(t *testing.T) {
+	s := []float64{2, 4}
+	if got, want := slice.AddScalar(s, 1), []float64{3, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("AddScalar() = %v, want %v", got, want)
+	}
+	if got, want := slice.SubScalar(s, 1), []float64{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SubScalar() = %v, want %v", got, want)
+	}
+	if got, want := slice.MulScalar(s, 3), []float64{6, 12}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MulScalar() = %v, want %v", got, want)
+	}
+	if got, err := slice.DivScalar(s, 2); err != nil || !reflect.DeepEqual(got, []float64{1, 2}) {
+		t.Errorf("DivScalar() = %v, %v", got, err)
+	}
+	if _, err := slice.DivScalar(s, 0); !errors.Is(err, slice.ErrDivisionByZero) {
+		t.Errorf("DivScalar(0) error = %v", err)
+	}
+}
+
+func TestVector(t *testing.T) {
+	if got, err := slice.Dot([]int{1, 2, 3}, []int{4, 5, 6}); got != 32 || err != nil {
+		t.Errorf("Dot() = %v, %v, want 32", got, err)
+	}
+	if got := slice.Norm([]int{3, 4}); got != 5 {
+		t.Errorf("Norm() = %v, want 5", got)
+	}
+	if got, want := slice.Normalize([]int{3, 4}), []float64{0.6, 0.8}; math.Abs(got[0]-want[0]) > 1e-12 || math.Abs(got[1]-want[1]) > 1e-12 {
+		t.Errorf("Normalize() = %v, want %v", got, want)
+	}
+	if got, want := slice.Normalize([]int{0, 0}), []float64{0, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Normalize(zero) = %v, want %v", got, want)
+	}
+	if got, want := slice.CumSum([]int{1, 2, 3, 4}), []int{1, 3, 6, 10}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CumSum() = %v, want %v", got, want)
+	}
+	if got, want := slice.Diff([]int{1, 4, 9, 16}), []int{3, 5, 7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %v, want %v", got, want)
+	}
+	if got := slice.Diff([]int{1}); len(got) != 0 {
+		t.Errorf("Diff(single) = %v, want empty", got)
+	}
+}
+
+func TestNumberSlice(t *testing.T) {
+	v := slice.NewNumberSlice(1.0, 2.0, 3.0)
+	if _, err := v.Add(slice.NewAdvancedSlice(1.0, 1.0, 1.0)); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	v.MulScalar(2).Map(func(x float64, _ int) float64 { return x - 1 })
+	if got, want := v.Values(), []float64{3, 5, 7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if _, err := v.Sub(slice.NewAdvancedSlice(1.0)); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("Sub() error = %v", err)
+	}
+	if got, want := v.Values(), []float64{3, 5, 7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v after failed Sub, want %v", got, want)
+	}
+	v.Diff()
+	if got, want := v.Values(), []float64{2, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %v, want %v", got, want)
+	}
+	v.Push(10).(*slice.NumberSlice[float64]).CumSum()
+	if got, want := v.Values(), []float64{2, 4, 14}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CumSum() = %v, want %v", got, want)
+	}
+	v.Clip(3, 10)
+	if got, want := v.Values(), []float64{3, 4, 10}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Clip() = %v, want %v", got, want)
+	}
+	if hi, _ := v.Max(); hi != 10 {
+		t.Errorf("Max() = %v, want 10", hi)
+	}
+}
@@ -0,0 +1,493 @@
+package slice
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// EventKind identifies the kind of change an ObservableSlice reports.
+type EventKind int
+
+const (
+	// EventInserted reports elements added to the slice. Indexes are their positions after insertion.
+	EventInserted EventKind = iota
+	// EventRemoved reports elements removed from the slice. Indexes are their positions before removal.
+	EventRemoved
+	// EventUpdated reports elements replaced in place. Values holds the new elements and Old the previous ones.
+	EventUpdated
+	// EventReordered reports a permutation. Indexes[i] is the previous position of the element now at i.
+	EventReordered
+	// EventCleared reports that every element was removed. Values holds the previous elements.
+	EventCleared
+)
+
+// String returns the name of the event kind.
+func (k EventKind) String() string {
+	switch k {
+	case EventInserted:
+		return "Inserted"
+	case EventRemoved:
+		return "Removed"
+	case EventUpdated:
+		return "Updated"
+	case EventReordered:
+		return "Reordered"
+	case EventCleared:
+		return "Cleared"
+	}
+	return fmt.Sprintf("EventKind(%d)", int(k))
+}
+
+// Event describes one change to an ObservableSlice.
+// Events own their slices, so subscribers may keep them.
+type Event[T any] struct {
+	Kind    EventKind
+	Indexes []int
+	Values  []T
+	Old     []T
+}
+
+var _ IAdvancedSlice[any] = (*ObservableSlice[any])(nil)
+
+// ObservableSlice is an advanced slice that reports every mutation to its subscribers as an Event.
+// Mutations that cannot be described element by element, such as Slice and CopyWithIn, are reported
+// as EventCleared followed by EventInserted. Mutations that touch no element emit no event; elements
+// are not compared, so Map and Fill report every element they overwrite even if its value is unchanged.
+//
+// Subscribing and unsubscribing are safe from any goroutine; mutating the slice is not,
+// as with every other advanced slice.
+type ObservableSlice[T any] struct {
+	*advancedSlice[T]
+	mu          sync.RWMutex
+	subscribers []*subscriber[T]
+}
+
+// subscriber is a registered event handler.
+// Asynchronous subscribers receive events through ch, drained by their own goroutine, which closes done on exit.
+// mu serializes sends on ch with closing it, and closed records that it has been closed.
+type subscriber[T any] struct {
+	f      func(Event[T])
+	ch     chan Event[T]
+	done   chan struct{}
+	mu     sync.Mutex
+	closed bool
+}
+
+// send queues e for an asynchronous subscriber, unless it has been unsubscribed.
+func (sub *subscriber[T]) send(e Event[T]) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if !sub.closed {
+		sub.ch <- e
+	}
+}
+
+// close stops an asynchronous subscriber and waits until it has handled every queued event.
+func (sub *subscriber[T]) close() {
+	sub.mu.Lock()
+	sub.closed = true
+	close(sub.ch)
+	sub.mu.Unlock()
+	<-sub.done
+}
+
+// NewObservableSlice creates a new observable slice.
+//
+// Parameters:
+//   - data: The initial elements. No event is emitted for them.
+//
+// Returns:
+//
+//   - *ObservableSlice[T]: The observable slice.
+//
+// Example:
+//
+//	items := NewObservableSlice[string]()
+//	unsubscribe := items.Subscribe(func(e Event[string]) {
+//		fmt.Println(e.Kind, e.Indexes, e.Values)
+//	})
+//	defer unsubscribe()
+//	items.Push("a", "b") // Inserted [0 1] [a b]
+func NewObservableSlice[T any](data ...T) *ObservableSlice[T] {
+	return &ObservableSlice[T]{advancedSlice: &advancedSlice[T]{data: data}}
+}
+
+// Subscribe registers f to be called synchronously, on the mutating goroutine, after each change.
+//
+// Parameters:
+//   - f: The event handler. It may unsubscribe itself but must not mutate the slice.
+//
+// Returns:
+//
+//   - func(): A function that unsubscribes f. It is safe to call more than once.
+func (s *ObservableSlice[T]) Subscribe(f func(Event[T])) func() {
+	return s.subscribe(&subscriber[T]{f: f})
+}
+
+// SubscribeAsync registers f to be called on a dedicated goroutine, in event order.
+// Up to buffer events are queued; beyond that the mutating goroutine waits for f to catch up.
+//
+// Parameters:
+//   - buffer: The number of events that may be queued.
+//   - f: The event handler.
+//
+// Returns:
+//
+//   - func(): A function that unsubscribes f and waits until every queued event has been handled.
+//     It is safe to call more than once but must not be called from f.
+func (s *ObservableSlice[T]) SubscribeAsync(buffer int, f func(Event[T])) func() {
+	sub := &subscriber[T]{f: f, ch: make(chan Event[T], max(buffer, 0)), done: make(chan struct{})}
+	go func() {
+		defer close(sub.done)
+		for e := range sub.ch {
+			f(e)
+		}
+	}()
+	return s.subscribe(sub)
+}
+
+// subscribe registers sub and returns its unsubscribe function.
+func (s *ObservableSlice[T]) subscribe(sub *subscriber[T]) func() {
+	s.mu.Lock()
+	s.subscribers = append(s.subscribers, sub)
+	s.mu.Unlock()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			s.subscribers = slices.DeleteFunc(s.subscribers, func(x *subscriber[T]) bool { return x == sub })
+			s.mu.Unlock()
+			if sub.ch != nil {
+				sub.close()
+			}
+		})
+	}
+}
+
+// emit delivers e to every subscriber in a snapshot of the list taken under the read lock.
+// Delivery happens outside the lock, so a handler that is slow, blocked or subscribing does not hold up
+// Subscribe and unsubscribe; a subscriber removed after the snapshot may still see this one event.
+func (s *ObservableSlice[T]) emit(e Event[T]) {
+	s.mu.RLock()
+	subs := slices.Clone(s.subscribers)
+	s.mu.RUnlock()
+	for _, sub := range subs {
+		if sub.ch != nil {
+			sub.send(e)
+		} else {
+			sub.f(e)
+		}
+	}
+}
+
+// inserted emits EventInserted for n elements starting at index start.
+func (s *ObservableSlice[T]) inserted(start, n int) {
+	if n == 0 {
+		return
+	}
+	e := Event[T]{Kind: EventInserted, Indexes: make([]int, n), Values: slices.Clone(s.data[start : start+n])}
+	for i := range e.Indexes {
+		e.Indexes[i] = start + i
+	}
+	s.emit(e)
+}
+
+// removed emits EventRemoved for a single element.
+func (s *ObservableSlice[T]) removed(index int, value T) {
+	s.emit(Event[T]{Kind: EventRemoved, Indexes: []int{index}, Values: []T{value}})
+}
+
+// replaced reports a wholesale change from old to the current contents.
+func (s *ObservableSlice[T]) replaced(old []T) {
+	if len(old) > 0 {
+		s.emit(Event[T]{Kind: EventCleared, Values: old})
+	}
+	s.inserted(0, len(s.data))
+}
+
+// reorder applies the permutation perm, where perm[i] is the previous position of the new element i.
+func (s *ObservableSlice[T]) reorder(perm []int) {
+	if len(perm) < 2 {
+		return
+	}
+	permute(s.data, perm)
+	s.emit(Event[T]{Kind: EventReordered, Indexes: perm})
+}
+
+// identity returns the slice [0, 1, ..., n-1].
+func identity(n int) []int {
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	return perm
+}
+
+// Clear removes every element.
+//
+// Returns:
+//
+//   - *ObservableSlice[T]: The observable slice, for chaining.
+func (s *ObservableSlice[T]) Clear() *ObservableSlice[T] {
+	old := s.data
+	s.data = nil
+	if len(old) > 0 {
+		s.emit(Event[T]{Kind: EventCleared, Values: old})
+	}
+	return s
+}
+
+// Push appends the values and emits EventInserted.
+func (s *ObservableSlice[T]) Push(values ...T) IAdvancedSlice[T] {
+	n := len(s.data)
+	s.advancedSlice.Push(values...)
+	s.inserted(n, len(values))
+	return s
+}
+
+// PushSlice appends the elements of the given slices and emits a single EventInserted.
+func (s *ObservableSlice[T]) PushSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	return s.Push(Concat(Map(values, func(v IAdvancedSlice[T], _ int) []T { return v.Values() })...)...)
+}
+
+// Concat appends the elements of the given slices and emits a single EventInserted.
+func (s *ObservableSlice[T]) Concat(ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	return s.PushSlice(ss...)
+}
+
+// Unshift prepends the values and emits EventInserted.
+func (s *ObservableSlice[T]) Unshift(values ...T) IAdvancedSlice[T] {
+	s.advancedSlice.Unshift(values...)
+	s.inserted(0, len(values))
+	return s
+}
+
+// UnshiftSlice prepends the elements of each given slice in turn, emitting EventInserted for each.
+func (s *ObservableSlice[T]) UnshiftSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	for _, v := range values {
+		s.Unshift(v.Values()...)
+	}
+	return s
+}
+
+// Pop removes and returns the last element, emitting EventRemoved.
+func (s *ObservableSlice[T]) Pop() T {
+	if len(s.data) == 0 {
+		return s.advancedSlice.Pop()
+	}
+	v, _ := s.PopIs()
+	return v
+}
+
+// PopIs removes and returns the last element, emitting EventRemoved.
+func (s *ObservableSlice[T]) PopIs() (T, bool) {
+	v, ok := s.advancedSlice.PopIs()
+	if ok {
+		s.removed(len(s.data), v)
+	}
+	return v, ok
+}
+
+// PopErr removes and returns the last element, emitting EventRemoved.
+func (s *ObservableSlice[T]) PopErr() (T, error) {
+	v, err := s.advancedSlice.PopErr()
+	if err == nil {
+		s.removed(len(s.data), v)
+	}
+	return v, err
+}
+
+// Shift removes and returns the first element, emitting EventRemoved.
+func (s *ObservableSlice[T]) Shift() T {
+	if len(s.data) == 0 {
+		return s.advancedSlice.Shift()
+	}
+	v, _ := s.ShiftIs()
+	return v
+}
+
+// ShiftIs removes and returns the first element, emitting EventRemoved.
+func (s *ObservableSlice[T]) ShiftIs() (T, bool) {
+	v, ok := s.advancedSlice.ShiftIs()
+	if ok {
+		s.removed(0, v)
+	}
+	return v, ok
+}
+
+// ShiftErr removes and returns the first element, emitting EventRemoved.
+func (s *ObservableSlice[T]) ShiftErr() (T, error) {
+	v, err := s.advancedSlice.ShiftErr()
+	if err == nil {
+		s.removed(0, v)
+	}
+	return v, err
+}
+
+// Remove removes the elements that satisfy f and emits a single EventRemoved.
+func (s *ObservableSlice[T]) Remove(f func(T, int) bool) IAdvancedSlice[T] {
+	s.removeMatching(f)
+	return s
+}
+
+// removeMatching removes the elements that satisfy f and emits a single EventRemoved.
+func (s *ObservableSlice[T]) removeMatching(f func(T, int) bool) {
+	e := Event[T]{Kind: EventRemoved}
+	kept := make([]T, 0, len(s.data))
+	for i, v := range s.data {
+		if f(v, i) {
+			e.Indexes = append(e.Indexes, i)
+			e.Values = append(e.Values, v)
+		} else {
+			kept = append(kept, v)
+		}
+	}
+	s.data = kept
+	if len(e.Indexes) > 0 {
+		s.emit(e)
+	}
+}
+
+// RemoveAt removes the element at index and emits EventRemoved.
+func (s *ObservableSlice[T]) RemoveAt(index int) IAdvancedSlice[T] {
+	if i, ok := s.resolve(index); ok {
+		s.removeIndex(i)
+	}
+	return s
+}
+
+// RemoveAtErr removes the element at index and emits EventRemoved, or returns an error as RemoveAtErr does.
+func (s *ObservableSlice[T]) RemoveAtErr(index int) (IAdvancedSlice[T], error) {
+	i, err := resolveIndexErr(len(s.data), index, s.bounds)
+	if err != nil {
+		return s, err
+	}
+	s.removeIndex(i)
+	return s, nil
+}
+
+// removeIndex removes the element at a resolved index and emits EventRemoved.
+func (s *ObservableSlice[T]) removeIndex(i int) {
+	v := s.data[i]
+	s.data = RemoveAt(s.data, i)
+	s.removed(i, v)
+}
+
+// Unique keeps the first occurrence of each key returned by f and emits EventRemoved for the rest.
+func (s *ObservableSlice[T]) Unique(f func(T) string) IAdvancedSlice[T] {
+	if f == nil {
+		f = s.key
+	}
+	if f == nil {
+		return s
+	}
+	seen := make(map[string]struct{}, len(s.data))
+	s.removeMatching(func(v T, _ int) bool {
+		k := f(v)
+		if _, ok := seen[k]; ok {
+			return true
+		}
+		seen[k] = struct{}{}
+		return false
+	})
+	return s
+}
+
+// Map replaces each element with the result of f and emits EventUpdated for every element.
+func (s *ObservableSlice[T]) Map(f func(T, int) T) IAdvancedSlice[T] {
+	old := slices.Clone(s.data)
+	s.advancedSlice.Map(f)
+	if len(old) > 0 {
+		s.emit(Event[T]{Kind: EventUpdated, Indexes: identity(len(old)), Values: slices.Clone(s.data), Old: old})
+	}
+	return s
+}
+
+// Fill sets the selected elements to value and emits EventUpdated.
+func (s *ObservableSlice[T]) Fill(value T, index ...int) IAdvancedSlice[T] {
+	// Run Fill over positions to learn which elements it touches, then apply it for real.
+	positions := Fill(identity(len(s.data)), -1, index...)
+	old := slices.Clone(s.data)
+	s.advancedSlice.Fill(value, index...)
+	e := Event[T]{Kind: EventUpdated}
+	for i, p := range positions {
+		if p == -1 {
+			e.Indexes = append(e.Indexes, i)
+			e.Values = append(e.Values, value)
+			e.Old = append(e.Old, old[i])
+		}
+	}
+	if len(e.Indexes) > 0 {
+		s.emit(e)
+	}
+	return s
+}
+
+// Sort sorts the elements using f, stably, and emits EventReordered.
+func (s *ObservableSlice[T]) Sort(f func(T, T) bool) IAdvancedSlice[T] {
+	if f == nil {
+		f = s.less
+	}
+	if f == nil {
+		return s
+	}
+	perm := identity(len(s.data))
+	sort.SliceStable(perm, func(i, j int) bool {
+		return f(s.data[perm[i]], s.data[perm[j]])
+	})
+	s.reorder(perm)
+	return s
+}
+
+// Reverse reverses the order of the elements and emits EventReordered.
+func (s *ObservableSlice[T]) Reverse() IAdvancedSlice[T] {
+	s.reorder(Reverse(identity(len(s.data))))
+	return s
+}
+
+// Shuffle randomly permutes the elements in place and emits EventReordered.
+func (s *ObservableSlice[T]) Shuffle(r *rand.Rand) IAdvancedSlice[T] {
+	s.reorder(Shuffle(identity(len(s.data)), r))
+	return s
+}
+
+// Slice keeps only the selected subset, emitting EventCleared and EventInserted.
+func (s *ObservableSlice[T]) Slice(index ...int) IAdvancedSlice[T] {
+	old := slices.Clone(s.data)
+	s.advancedSlice.Slice(index...)
+	s.replaced(old)
+	return s
+}
+
+// SliceErr keeps only the selected subset, or returns an error as SliceErr does.
+func (s *ObservableSlice[T]) SliceErr(index ...int) (IAdvancedSlice[T], error) {
+	old := slices.Clone(s.data)
+	_, err := s.advancedSlice.SliceErr(index...)
+	if err == nil {
+		s.replaced(old)
+	}
+	return s, err
+}
+
+// CopyWithIn keeps only the elements at the given indices, emitting EventCleared and EventInserted.
+func (s *ObservableSlice[T]) CopyWithIn(indexes ...int) IAdvancedSlice[T] {
+	old := slices.Clone(s.data)
+	s.advancedSlice.CopyWithIn(indexes...)
+	s.replaced(old)
+	return s
+}
+
+// Begin starts a transaction over the slice.
+func (s *ObservableSlice[T]) Begin(validators ...Validator[T]) *Tx[T] {
+	return Begin[T](s, validators...)
+}
+
+// replace swaps in new contents, emitting EventCleared and EventInserted.
+func (s *ObservableSlice[T]) replace(values []T) {
+	old := s.data
+	s.data = values
+	s.replaced(old)
+}
@@ -0,0 +1,219 @@
+package slice_test
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestObservableSliceEvents(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	tests := []struct {
+		name   string
+		data   []int
+		mutate func(s *slice.ObservableSlice[int])
+		want   []slice.Event[int]
+		values []int
+	}{
+		{
+			name:   "Push",
+			data:   []int{1},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Push(2, 3) },
+			want:   []slice.Event[int]{{Kind: slice.EventInserted, Indexes: []int{1, 2}, Values: []int{2, 3}}},
+			values: []int{1, 2, 3},
+		},
+		{
+			name:   "Push nothing",
+			data:   []int{1},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Push() },
+			values: []int{1},
+		},
+		{
+			name:   "Unshift",
+			data:   []int{3},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Unshift(1, 2) },
+			want:   []slice.Event[int]{{Kind: slice.EventInserted, Indexes: []int{0, 1}, Values: []int{1, 2}}},
+			values: []int{1, 2, 3},
+		},
+		{
+			name:   "Pop",
+			data:   []int{1, 2, 3},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Pop() },
+			want:   []slice.Event[int]{{Kind: slice.EventRemoved, Indexes: []int{2}, Values: []int{3}}},
+			values: []int{1, 2},
+		},
+		{
+			name:   "Pop empty",
+			mutate: func(s *slice.ObservableSlice[int]) { s.Pop() },
+		},
+		{
+			name:   "Shift",
+			data:   []int{1, 2, 3},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Shift() },
+			want:   []slice.Event[int]{{Kind: slice.EventRemoved, Indexes: []int{0}, Values: []int{1}}},
+			values: []int{2, 3},
+		},
+		{
+			name:   "Remove",
+			data:   []int{1, 2, 3, 4},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Remove(func(v, _ int) bool { return v%2 == 0 }) },
+			want:   []slice.Event[int]{{Kind: slice.EventRemoved, Indexes: []int{1, 3}, Values: []int{2, 4}}},
+			values: []int{1, 3},
+		},
+		{
+			name:   "RemoveAt",
+			data:   []int{1, 2, 3},
+			mutate: func(s *slice.ObservableSlice[int]) { s.RemoveAt(1).RemoveAt(5) },
+			want:   []slice.Event[int]{{Kind: slice.EventRemoved, Indexes: []int{1}, Values: []int{2}}},
+			values: []int{1, 3},
+		},
+		{
+			name:   "Fill range",
+			data:   []int{1, 2, 3, 4},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Fill(0, 1, 3) },
+			want:   []slice.Event[int]{{Kind: slice.EventUpdated, Indexes: []int{1, 2}, Values: []int{0, 0}, Old: []int{2, 3}}},
+			values: []int{1, 0, 0, 4},
+		},
+		{
+			name:   "Fill negative",
+			data:   []int{1, 2, 3},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Fill(0, -2) },
+			want:   []slice.Event[int]{{Kind: slice.EventUpdated, Indexes: []int{1, 2}, Values: []int{0, 0}, Old: []int{2, 3}}},
+			values: []int{1, 0, 0},
+		},
+		{
+			name:   "Map",
+			data:   []int{1, 2},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Map(func(v, _ int) int { return v * 10 }) },
+			want:   []slice.Event[int]{{Kind: slice.EventUpdated, Indexes: []int{0, 1}, Values: []int{10, 20}, Old: []int{1, 2}}},
+			values: []int{10, 20},
+		},
+		{
+			name:   "Sort",
+			data:   []int{3, 1, 2},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Sort(less) },
+			want:   []slice.Event[int]{{Kind: slice.EventReordered, Indexes: []int{1, 2, 0}}},
+			values: []int{1, 2, 3},
+		},
+		{
+			name:   "Reverse",
+			data:   []int{1, 2, 3},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Reverse() },
+			want:   []slice.Event[int]{{Kind: slice.EventReordered, Indexes: []int{2, 1, 0}}},
+			values: []int{3, 2, 1},
+		},
+		{
+			name:   "Slice",
+			data:   []int{1, 2, 3},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Slice(1) },
+			want: []slice.Event[int]{
+				{Kind: slice.EventCleared, Values: []int{1, 2, 3}},
+				{Kind: slice.EventInserted, Indexes: []int{0, 1}, Values: []int{2, 3}},
+			},
+			values: []int{2, 3},
+		},
+		{
+			name:   "Clear",
+			data:   []int{1, 2},
+			mutate: func(s *slice.ObservableSlice[int]) { s.Clear() },
+			want:   []slice.Event[int]{{Kind: slice.EventCleared, Values: []int{1, 2}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := slice.NewObservableSlice(tt.data...)
+			var got []slice.Event[int]
+			s.Subscribe(func(e slice.Event[int]) { got = append(got, e) })
+			tt.mutate(s)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("events = %+v, want %+v", got, tt.want)
+			}
+			if got := s.Values(); (len(got) != 0 || len(tt.values) != 0) && !reflect.DeepEqual(got, tt.values) {
+				t.Errorf("Values() = %v, want %v", got, tt.values)
+			}
+		})
+	}
+}
+
+func TestObservableSliceUnsubscribe(t *testing.T) {
+	s := slice.NewObservableSlice[int]()
+	var a, b int
+	unsubscribeA := s.Subscribe(func(slice.Event[int]) { a++ })
+	var unsubscribeB func()
+	unsubscribeB = s.Subscribe(func(slice.Event[int]) {
+		b++
+		unsubscribeB() // a handler may unsubscribe itself
+	})
+	s.Push(1)
+	unsubscribeA()
+	unsubscribeA()
+	s.Push(2)
+	if a != 1 || b != 1 {
+		t.Errorf("deliveries = %d, %d, want 1, 1", a, b)
+	}
+}
+
+func TestObservableSliceAsync(t *testing.T) {
+	checkLeaks(t)
+	s := slice.NewObservableSlice[int]()
+	var mu sync.Mutex
+	var kinds []slice.EventKind
+	unsubscribe := s.SubscribeAsync(2, func(e slice.Event[int]) {
+		mu.Lock()
+		kinds = append(kinds, e.Kind)
+		mu.Unlock()
+	})
+	s.Push(1, 2, 3)
+	s.Pop()
+	s.Reverse()
+	s.Clear()
+	unsubscribe()
+	want := []slice.EventKind{slice.EventInserted, slice.EventRemoved, slice.EventReordered, slice.EventCleared}
+	if !reflect.DeepEqual(kinds, want) {
+		t.Errorf("kinds = %v, want %v", kinds, want)
+	}
+	s.Push(4)
+	if len(kinds) != len(want) {
+		t.Errorf("event delivered after unsubscribe: %v", kinds)
+	}
+}
+
+func TestObservableSliceAsyncHandlerSubscribes(t *testing.T) {
+	s := slice.NewObservableSlice[int]()
+	var seen atomic.Int32
+	unsubscribe := s.SubscribeAsync(0, func(slice.Event[int]) {
+		// Registering another handler while the slice is sending to this one must not deadlock.
+		s.Subscribe(func(slice.Event[int]) {})()
+		seen.Add(1)
+	})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := range 5 {
+			s.Push(i)
+		}
+		unsubscribe()
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Push blocked while an asynchronous handler subscribed")
+	}
+	if got := seen.Load(); got != 5 {
+		t.Errorf("handled %d events, want 5", got)
+	}
+}
+
+func TestObservableSliceChaining(t *testing.T) {
+	s := slice.NewObservableSlice(3, 1, 2)
+	if got := s.Push(4).Sort(func(a, b int) bool { return a < b }).RemoveAt(0); got != s {
+		t.Errorf("chained call returned %T, want the receiver", got)
+	}
+	if got := slice.EventReordered.String(); got != "Reordered" {
+		t.Errorf("String() = %q", got)
+	}
+}
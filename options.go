@@ -0,0 +1,115 @@
+package slice
+
+// Option configures an advanced slice of T created by NewAdvancedSliceWith.
+// Options that do not take a function of T, such as WithCopy, need T spelled out: WithCopy[int]().
+type Option[T any] func(*options[T])
+
+// options holds the settings collected from Option values.
+type options[T any] struct {
+	capacity int
+	copy     bool
+	less     func(a, b T) bool
+	key      func(T) string
+	bounds   BoundsPolicy
+}
+
+// WithCapacity reserves room for at least n elements.
+// If the input data has a smaller capacity it is copied into a new backing array.
+//
+// Parameters:
+//   - n: The minimum capacity.
+//
+// Returns:
+//
+//   - Option[T]: The option.
+func WithCapacity[T any](n int) Option[T] {
+	return func(o *options[T]) {
+		o.capacity = n
+	}
+}
+
+// WithCopy copies the input data instead of aliasing it, so later changes to either side are not shared.
+//
+// Returns:
+//
+//   - Option[T]: The option.
+func WithCopy[T any]() Option[T] {
+	return func(o *options[T]) {
+		o.copy = true
+	}
+}
+
+// WithComparator sets the comparison function Sort uses when it is called with nil.
+//
+// Parameters:
+//   - less: A comparison function that determines the order of elements.
+//
+// Returns:
+//
+//   - Option[T]: The option.
+func WithComparator[T any](less func(a, b T) bool) Option[T] {
+	return func(o *options[T]) {
+		o.less = less
+	}
+}
+
+// WithKey sets the key function Unique uses when it is called with nil.
+//
+// Parameters:
+//   - key: A function that extracts a key from each element.
+//
+// Returns:
+//
+//   - Option[T]: The option.
+func WithKey[T any](key func(T) string) Option[T] {
+	return func(o *options[T]) {
+		o.key = key
+	}
+}
+
+// WithBoundsPolicy sets how out-of-range indices and removals from an empty slice are handled.
+//
+// Parameters:
+//   - policy: The bounds policy.
+//
+// Returns:
+//
+//   - Option[T]: The option.
+func WithBoundsPolicy[T any](policy BoundsPolicy) Option[T] {
+	return func(o *options[T]) {
+		o.bounds = policy
+	}
+}
+
+// NewAdvancedSliceWith creates a new instance of an advanced slice configured by options.
+//
+// Parameters:
+//   - data: The initial data. It is aliased unless WithCopy is given or WithCapacity requires a larger backing array.
+//   - opts: Options that configure the slice.
+//
+// Returns:
+//
+//   - IAdvancedSlice[T]: An interface of type IAdvancedSlice[T] honoring the options.
+//
+// Example:
+//
+//	s := NewAdvancedSliceWith(users,
+//		WithCopy[User](),
+//		WithComparator(func(a, b User) bool { return a.Age < b.Age }),
+//		WithKey(func(u User) string { return u.Email }),
+//		WithBoundsPolicy[User](BoundsClamp),
+//	)
+//	s.Sort(nil).Unique(nil)
+func NewAdvancedSliceWith[T any](data []T, opts ...Option[T]) IAdvancedSlice[T] {
+	var o options[T]
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	s := &advancedSlice[T]{data: data, less: o.less, key: o.key, bounds: o.bounds}
+	if o.copy || cap(data) < o.capacity {
+		s.data = make([]T, len(data), max(len(data), o.capacity))
+		copy(s.data, data)
+	}
+	return s
+}
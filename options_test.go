@@ -0,0 +1,120 @@
+package slice_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestNewAdvancedSliceWithCopy(t *testing.T) {
+	data := []int{1, 2, 3}
+	s := slice.NewAdvancedSliceWith(data, slice.WithCopy[int]())
+	s.Fill(0)
+	if !reflect.DeepEqual(data, []int{1, 2, 3}) {
+		t.Errorf("input modified through copied slice: %v", data)
+	}
+
+	aliased := slice.NewAdvancedSliceWith(data)
+	aliased.Fill(0)
+	if !reflect.DeepEqual(data, []int{0, 0, 0}) {
+		t.Errorf("input not shared without WithCopy: %v", data)
+	}
+}
+
+func TestNewAdvancedSliceWithCapacity(t *testing.T) {
+	s := slice.NewAdvancedSliceWith([]int{1, 2}, slice.WithCapacity[int](10))
+	if got := cap(s.Values()); got < 10 {
+		t.Errorf("cap(Values()) = %d, want >= 10", got)
+	}
+	if got := s.Values(); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("Values() = %v, want [1 2]", got)
+	}
+}
+
+func TestNewAdvancedSliceWithComparatorAndKey(t *testing.T) {
+	s := slice.NewAdvancedSliceWith([]int{3, 1, 13, 2, 11},
+		slice.WithComparator(func(a, b int) bool { return a < b }),
+		slice.WithKey(func(v int) string { return strconv.Itoa(v % 10) }),
+	)
+	s.Sort(nil)
+	if got, want := s.Values(), []int{1, 2, 3, 11, 13}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort(nil) = %v, want %v", got, want)
+	}
+	s.Unique(nil)
+	if got, want := s.Values(), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unique(nil) = %v, want %v", got, want)
+	}
+	s.Sort(func(a, b int) bool { return a > b })
+	if got, want := s.Values(), []int{3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort(f) = %v, want %v", got, want)
+	}
+}
+
+func TestNewAdvancedSliceWithBoundsPolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     slice.BoundsPolicy
+		index      int
+		wantAt     int
+		wantRemove []int
+		wantPanic  bool
+	}{
+		{"zero in range", slice.BoundsZero, 1, 2, []int{1, 3}, false},
+		{"zero high", slice.BoundsZero, 5, 0, []int{1, 2, 3}, false},
+		{"zero negative", slice.BoundsZero, -1, 3, []int{1, 2}, false},
+		{"zero negative high", slice.BoundsZero, -4, 0, []int{1, 2, 3}, false},
+		{"clamp high", slice.BoundsClamp, 5, 3, []int{1, 2}, false},
+		{"clamp negative", slice.BoundsClamp, -4, 1, []int{2, 3}, false},
+		{"panic", slice.BoundsPanic, 5, 0, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Errorf("panic = %v, wantPanic %v", r, tt.wantPanic)
+				}
+			}()
+			s := slice.NewAdvancedSliceWith([]int{1, 2, 3}, slice.WithCopy[int](), slice.WithBoundsPolicy[int](tt.policy))
+			if got := s.At(tt.index); got != tt.wantAt {
+				t.Errorf("At(%d) = %v, want %v", tt.index, got, tt.wantAt)
+			}
+			if got := s.RemoveAt(tt.index).Values(); !reflect.DeepEqual(got, tt.wantRemove) {
+				t.Errorf("RemoveAt(%d) = %v, want %v", tt.index, got, tt.wantRemove)
+			}
+		})
+	}
+}
+
+func TestSortAndUniqueNilUseConfiguredFunctions(t *testing.T) {
+	tests := []struct {
+		name string
+		new  func(data ...int) slice.IAdvancedSlice[int]
+	}{
+		{"advanced", func(data ...int) slice.IAdvancedSlice[int] { return slice.NewAdvancedSlice(data...) }},
+		{"deque", func(data ...int) slice.IAdvancedSlice[int] { return slice.NewDeque(data...) }},
+		{"segmented", func(data ...int) slice.IAdvancedSlice[int] { return slice.NewSegmentedSlice(2, data...) }},
+		{"observable", func(data ...int) slice.IAdvancedSlice[int] { return slice.NewObservableSlice(data...) }},
+		{"history", func(data ...int) slice.IAdvancedSlice[int] { return slice.NewHistorySlice(0, data...) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := tt.new(3, 1, 13, 2, 11)
+			s.Sort(nil).Unique(nil)
+			if got, want := s.Values(), []int{3, 1, 13, 2, 11}; !reflect.DeepEqual(got, want) {
+				t.Errorf("unconfigured Sort(nil).Unique(nil) = %v, want %v", got, want)
+			}
+			s.SetComparator(func(a, b int) bool { return a < b })
+			s.SetKey(func(v int) string { return strconv.Itoa(v % 10) })
+			s.Sort(nil)
+			if got, want := s.Values(), []int{1, 2, 3, 11, 13}; !reflect.DeepEqual(got, want) {
+				t.Errorf("Sort(nil) = %v, want %v", got, want)
+			}
+			s.Unique(nil)
+			if got, want := s.Values(), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+				t.Errorf("Unique(nil) = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package slice
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+)
+
+// isNaN reports whether v is a floating-point NaN, the only value not equal to itself.
+func isNaN[T cmp.Ordered](v T) bool {
+	return v != v
+}
+
+// MinMax returns the smallest and largest elements of the slice in natural order.
+// NaN values are ignored unless every element is NaN.
+//
+// Parameters:
+//   - s: The slice to search.
+//
+// Returns:
+//
+//	The smallest and largest elements, and false if the slice is empty.
+func MinMax[T cmp.Ordered](s []T) (lo, hi T, ok bool) {
+	if len(s) == 0 {
+		return lo, hi, false
+	}
+	lo, hi = s[0], s[0]
+	for _, v := range s[1:] {
+		if isNaN(v) {
+			continue
+		}
+		if isNaN(lo) || v < lo {
+			lo = v
+		}
+		if isNaN(hi) || v > hi {
+			hi = v
+		}
+	}
+	return lo, hi, true
+}
+
+// Min returns the smallest element of the slice in natural order, ignoring NaN values unless every element is NaN.
+//
+// Parameters:
+//   - s: The slice to search.
+//
+// Returns:
+//
+//	The smallest element, and false if the slice is empty.
+func Min[T cmp.Ordered](s []T) (T, bool) {
+	lo, _, ok := MinMax(s)
+	return lo, ok
+}
+
+// Max returns the largest element of the slice in natural order, ignoring NaN values unless every element is NaN.
+//
+// Parameters:
+//   - s: The slice to search.
+//
+// Returns:
+//
+//	The largest element, and false if the slice is empty.
+func Max[T cmp.Ordered](s []T) (T, bool) {
+	_, hi, ok := MinMax(s)
+	return hi, ok
+}
+
+// Clamp limits every element of the slice to the range [lo, hi]. NaN values are left unchanged.
+//
+// Parameters:
+//   - s: The slice to modify.
+//   - lo: The lower bound.
+//   - hi: The upper bound.
+//
+// Returns:
+//
+//	The modified slice.
+func Clamp[T cmp.Ordered](s []T, lo, hi T) []T {
+	for i, v := range s {
+		if isNaN(v) {
+			continue
+		}
+		s[i] = min(max(v, lo), hi)
+	}
+	return s
+}
+
+var _ IAdvancedSlice[int] = (*OrderedSlice[int])(nil)
+
+// OrderedSlice is an advanced slice of naturally ordered elements.
+// Sorting and searching need no comparator; NaN values sort before all other values, as with cmp.Compare.
+// It also provides every ComparableSlice lookup.
+type OrderedSlice[T cmp.Ordered] struct {
+	*ComparableSlice[T]
+}
+
+// NewOrderedSlice creates a new ordered slice.
+//
+// Parameters:
+//   - data: The initial elements.
+//
+// Returns:
+//
+//   - *OrderedSlice[T]: The ordered slice.
+//
+// Example:
+//
+//	s := NewOrderedSlice(3, 1, 2)
+//	s.Sort(nil)             // [1 2 3]
+//	lo, hi, _ := s.MinMax() // 1, 3
+func NewOrderedSlice[T cmp.Ordered](data ...T) *OrderedSlice[T] {
+	return &OrderedSlice[T]{ComparableSlice: NewComparableSlice(data...)}
+}
+
+// Sort sorts the elements using f, or in ascending natural order if f is nil.
+func (s *OrderedSlice[T]) Sort(f func(T, T) bool) IAdvancedSlice[T] {
+	if f == nil && s.less == nil {
+		slices.Sort(s.data)
+		return s
+	}
+	s.advancedSlice.Sort(f)
+	return s
+}
+
+// config returns the comparator, which defaults to natural order as in Sort, the key function and the bounds policy.
+func (s *OrderedSlice[T]) config() (func(a, b T) bool, func(T) string, BoundsPolicy) {
+	less, key, bounds := s.advancedSlice.config()
+	if less == nil {
+		less = cmp.Less[T]
+	}
+	return less, key, bounds
+}
+
+// SortDesc sorts the elements in descending natural order, with NaN values last.
+func (s *OrderedSlice[T]) SortDesc() IAdvancedSlice[T] {
+	slices.SortFunc(s.data, func(a, b T) int { return cmp.Compare(b, a) })
+	return s
+}
+
+// Min returns the smallest element, and false if the slice is empty.
+func (s *OrderedSlice[T]) Min() (T, bool) {
+	return Min(s.data)
+}
+
+// Max returns the largest element, and false if the slice is empty.
+func (s *OrderedSlice[T]) Max() (T, bool) {
+	return Max(s.data)
+}
+
+// MinMax returns the smallest and largest elements, and false if the slice is empty.
+func (s *OrderedSlice[T]) MinMax() (T, T, bool) {
+	return MinMax(s.data)
+}
+
+// Clamp limits every element to the range [lo, hi].
+func (s *OrderedSlice[T]) Clamp(lo, hi T) IAdvancedSlice[T] {
+	s.data = Clamp(s.data, lo, hi)
+	return s
+}
+
+// BinarySearch searches an ascending-sorted slice for the value.
+//
+// Parameters:
+//   - v: The value to look for.
+//
+// Returns:
+//
+//   - int (index): The position of v, or the position where it would be inserted.
+//   - bool: Whether v was found.
+func (s *OrderedSlice[T]) BinarySearch(v T) (int, bool) {
+	return slices.BinarySearch(s.data, v)
+}
+
+// Unique keeps the first occurrence of each key returned by f, or of each value if f is nil.
+func (s *OrderedSlice[T]) Unique(f func(T) string) IAdvancedSlice[T] {
+	s.ComparableSlice.Unique(f)
+	return s
+}
+
+// Replace replaces every occurrence of old with new.
+func (s *OrderedSlice[T]) Replace(old, new T) IAdvancedSlice[T] {
+	s.ComparableSlice.Replace(old, new)
+	return s
+}
+
+// Map replaces each element with the result of f.
+func (s *OrderedSlice[T]) Map(f func(T, int) T) IAdvancedSlice[T] {
+	s.advancedSlice.Map(f)
+	return s
+}
+
+// Concat appends the elements of the given slices.
+func (s *OrderedSlice[T]) Concat(ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	s.advancedSlice.Concat(ss...)
+	return s
+}
+
+// CopyWithIn keeps only the elements at the given indices.
+func (s *OrderedSlice[T]) CopyWithIn(indexes ...int) IAdvancedSlice[T] {
+	s.advancedSlice.CopyWithIn(indexes...)
+	return s
+}
+
+// Slice keeps only the selected subset.
+func (s *OrderedSlice[T]) Slice(index ...int) IAdvancedSlice[T] {
+	s.advancedSlice.Slice(index...)
+	return s
+}
+
+// Fill sets the selected elements to value.
+func (s *OrderedSlice[T]) Fill(value T, index ...int) IAdvancedSlice[T] {
+	s.advancedSlice.Fill(value, index...)
+	return s
+}
+
+// Push appends one or more elements.
+func (s *OrderedSlice[T]) Push(values ...T) IAdvancedSlice[T] {
+	s.advancedSlice.Push(values...)
+	return s
+}
+
+// PushSlice appends the elements of the given slices.
+func (s *OrderedSlice[T]) PushSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	s.advancedSlice.PushSlice(values...)
+	return s
+}
+
+// Unshift prepends one or more elements.
+func (s *OrderedSlice[T]) Unshift(values ...T) IAdvancedSlice[T] {
+	s.advancedSlice.Unshift(values...)
+	return s
+}
+
+// UnshiftSlice prepends the elements of each given slice in turn.
+func (s *OrderedSlice[T]) UnshiftSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	s.advancedSlice.UnshiftSlice(values...)
+	return s
+}
+
+// Reverse reverses the order of the elements.
+func (s *OrderedSlice[T]) Reverse() IAdvancedSlice[T] {
+	s.advancedSlice.Reverse()
+	return s
+}
+
+// Shuffle randomly permutes the elements in place.
+func (s *OrderedSlice[T]) Shuffle(r *rand.Rand) IAdvancedSlice[T] {
+	s.advancedSlice.Shuffle(r)
+	return s
+}
+
+// Remove removes the elements that satisfy f.
+func (s *OrderedSlice[T]) Remove(f func(T, int) bool) IAdvancedSlice[T] {
+	s.advancedSlice.Remove(f)
+	return s
+}
+
+// RemoveAt removes the element at index.
+func (s *OrderedSlice[T]) RemoveAt(index int) IAdvancedSlice[T] {
+	s.advancedSlice.RemoveAt(index)
+	return s
+}
+
+// RemoveAtErr removes the element at index, or returns an error as RemoveAtErr does.
+func (s *OrderedSlice[T]) RemoveAtErr(index int) (IAdvancedSlice[T], error) {
+	_, err := s.advancedSlice.RemoveAtErr(index)
+	return s, err
+}
+
+// SliceErr keeps only the selected subset, or returns an error as SliceErr does.
+func (s *OrderedSlice[T]) SliceErr(index ...int) (IAdvancedSlice[T], error) {
+	_, err := s.advancedSlice.SliceErr(index...)
+	return s, err
+}
+
+// Begin starts a transaction over the slice.
+func (s *OrderedSlice[T]) Begin(validators ...Validator[T]) *Tx[T] {
+	return Begin[T](s, validators...)
+}
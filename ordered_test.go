@@ -0,0 +1,99 @@
+package slice_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestMinMax(t *testing.T) {
+	nan := math.NaN()
+	tests := []struct {
+		name   string
+		s      []float64
+		lo, hi float64
+		ok     bool
+	}{
+		{"empty", nil, 0, 0, false},
+		{"single", []float64{2}, 2, 2, true},
+		{"mixed", []float64{3, -1, 7, 2}, -1, 7, true},
+		{"nan first", []float64{nan, 3, 1}, 1, 3, true},
+		{"nan middle", []float64{3, nan, 1}, 1, 3, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lo, hi, ok := slice.MinMax(tt.s)
+			if lo != tt.lo || hi != tt.hi || ok != tt.ok {
+				t.Errorf("MinMax(%v) = %v, %v, %v, want %v, %v, %v", tt.s, lo, hi, ok, tt.lo, tt.hi, tt.ok)
+			}
+			if got, _ := slice.Min(tt.s); got != tt.lo {
+				t.Errorf("Min(%v) = %v, want %v", tt.s, got, tt.lo)
+			}
+			if got, _ := slice.Max(tt.s); got != tt.hi {
+				t.Errorf("Max(%v) = %v, want %v", tt.s, got, tt.hi)
+			}
+		})
+	}
+
+	if lo, hi, ok := slice.MinMax([]float64{nan, nan}); !math.IsNaN(lo) || !math.IsNaN(hi) || !ok {
+		t.Errorf("MinMax(all NaN) = %v, %v, %v, want NaN, NaN, true", lo, hi, ok)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	got := slice.Clamp([]float64{-5, 0, 5, 10, math.NaN()}, 0, 6)
+	if want := []float64{0, 0, 5, 6}; !reflect.DeepEqual(got[:4], want) || !math.IsNaN(got[4]) {
+		t.Errorf("Clamp() = %v, want %v followed by NaN", got, want)
+	}
+}
+
+func TestOrderedSlice(t *testing.T) {
+	s := slice.NewOrderedSlice(5, 3, 9, 1)
+	s.Sort(nil)
+	if got, want := s.Values(), []int{1, 3, 5, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort(nil) = %v, want %v", got, want)
+	}
+	if i, found := s.BinarySearch(5); i != 2 || !found {
+		t.Errorf("BinarySearch(5) = %d, %v", i, found)
+	}
+	if i, found := s.BinarySearch(4); i != 2 || found {
+		t.Errorf("BinarySearch(4) = %d, %v", i, found)
+	}
+	s.SortDesc()
+	if got, want := s.Values(), []int{9, 5, 3, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortDesc() = %v, want %v", got, want)
+	}
+	if lo, hi, ok := s.MinMax(); lo != 1 || hi != 9 || !ok {
+		t.Errorf("MinMax() = %v, %v, %v", lo, hi, ok)
+	}
+	s.Clamp(2, 6)
+	if got, want := s.Values(), []int{6, 5, 3, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Clamp(2, 6) = %v, want %v", got, want)
+	}
+	if !s.Contains(5) {
+		t.Errorf("Contains(5) = false")
+	}
+
+	var as slice.IAdvancedSlice[int] = s
+	if _, ok := as.Push(0).(*slice.OrderedSlice[int]); !ok {
+		t.Errorf("Push() did not return the OrderedSlice")
+	}
+}
+
+func TestOrderedSliceNaN(t *testing.T) {
+	nan := math.NaN()
+	s := slice.NewOrderedSlice(2.0, nan, 1.0)
+	s.Sort(nil)
+	if got := s.Values(); !math.IsNaN(got[0]) || got[1] != 1 || got[2] != 2 {
+		t.Errorf("Sort(nil) = %v, want [NaN 1 2]", got)
+	}
+	s.SortDesc()
+	if got := s.Values(); got[0] != 2 || got[1] != 1 || !math.IsNaN(got[2]) {
+		t.Errorf("SortDesc() = %v, want [2 1 NaN]", got)
+	}
+	if v, _ := s.Min(); v != 1 {
+		t.Errorf("Min() = %v, want 1", v)
+	}
+}
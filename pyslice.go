@@ -0,0 +1,112 @@
+package slice
+
+import (
+	"math"
+)
+
+// None marks an omitted start or stop index in PySlice and PyFill, like leaving it out in Python's s[start:stop:step].
+const None = math.MinInt
+
+// pyIndices resolves Python-style start, stop and step against a sequence of length n,
+// following the rules of Python's slice.indices. It reports false if step is zero.
+func pyIndices(n int, indexes ...int) (start, stop, step int, ok bool) {
+	start, stop, step = None, None, 1
+	switch len(indexes) {
+	case 0:
+	case 1:
+		start = indexes[0]
+	case 2:
+		start, stop = indexes[0], indexes[1]
+	default:
+		start, stop, step = indexes[0], indexes[1], indexes[2]
+	}
+	if step == None {
+		step = 1
+	}
+	if step == 0 {
+		return 0, 0, 0, false
+	}
+
+	lower, upper := 0, n
+	if step < 0 {
+		lower, upper = -1, n-1
+	}
+	clamp := func(i, def int) int {
+		switch {
+		case i == None:
+			return def
+		case i < 0:
+			return max(i+n, lower)
+		default:
+			return min(i, upper)
+		}
+	}
+	if step > 0 {
+		start, stop = clamp(start, lower), clamp(stop, upper)
+	} else {
+		start, stop = clamp(start, upper), clamp(stop, lower)
+	}
+	return start, stop, step, true
+}
+
+// pyLength returns the number of positions visited by a resolved Python slice.
+func pyLength(start, stop, step int) int {
+	if step > 0 && start < stop {
+		return (stop-start-1)/step + 1
+	}
+	if step < 0 && start > stop {
+		return (start-stop-1)/-step + 1
+	}
+	return 0
+}
+
+// PySlice returns a new slice following Python's s[start:stop:step] semantics.
+// Negative indices count from the end, a negative step walks backwards, and out-of-range
+// indices are clamped. Use None for an omitted start or stop. The input is never modified.
+//
+// Parameters:
+//   - s: The original slice.
+//   - indexes: Optional start, stop and step, in that order.
+//
+// Returns:
+//
+//	A new slice with the selected elements, or nil if step is zero.
+//
+// Example:
+//
+//	PySlice([]int{1, 2, 3, 4, 5}, -2)             // [4 5]
+//	PySlice([]int{1, 2, 3, 4, 5}, None, None, -1) // [5 4 3 2 1]
+//	PySlice([]int{1, 2, 3, 4, 5}, 4, 0, -2)       // [5 3]
+func PySlice[T any](s []T, indexes ...int) []T {
+	start, stop, step, ok := pyIndices(len(s), indexes...)
+	if !ok {
+		return nil
+	}
+	list := make([]T, 0, pyLength(start, stop, step))
+	for i := 0; i < cap(list); i++ {
+		list = append(list, s[start+i*step])
+	}
+	return list
+}
+
+// PyFill sets the elements selected by Python-style start, stop and step to value.
+// It uses the same index rules as PySlice and never reorders the slice.
+//
+// Parameters:
+//   - s: The slice to fill.
+//   - value: The value to set.
+//   - indexes: Optional start, stop and step, in that order.
+//
+// Returns:
+//
+//	The modified slice, which shares storage with s.
+func PyFill[T any](s []T, value T, indexes ...int) []T {
+	start, stop, step, ok := pyIndices(len(s), indexes...)
+	if !ok {
+		return s
+	}
+	for i, n := 0, pyLength(start, stop, step); i < n; i++ {
+		s[start+i*step] = value
+	}
+	return s
+}
@@ -0,0 +1,74 @@
+package slice_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+// TestPySlice checks PySlice against results produced by Python for []int{1, 2, 3, 4, 5}.
+func TestPySlice(t *testing.T) {
+	tests := []struct {
+		name    string
+		indexes []int
+		want    []int
+	}{
+		{"[:]", []int{}, []int{1, 2, 3, 4, 5}},
+		{"[2:]", []int{2}, []int{3, 4, 5}},
+		{"[-2:]", []int{-2}, []int{4, 5}},
+		{"[1:3]", []int{1, 3}, []int{2, 3}},
+		{"[-3:-1]", []int{-3, -1}, []int{3, 4}},
+		{"[-1:-3]", []int{-1, -3}, []int{}},
+		{"[1:10]", []int{1, 10}, []int{2, 3, 4, 5}},
+		{"[-10:2]", []int{-10, 2}, []int{1, 2}},
+		{"[10:]", []int{10}, []int{}},
+		{"[::-1]", []int{slice.None, slice.None, -1}, []int{5, 4, 3, 2, 1}},
+		{"[::2]", []int{slice.None, slice.None, 2}, []int{1, 3, 5}},
+		{"[::-2]", []int{slice.None, slice.None, -2}, []int{5, 3, 1}},
+		{"[4:0:-2]", []int{4, 0, -2}, []int{5, 3}},
+		{"[-1:-10:-1]", []int{-1, -10, -1}, []int{5, 4, 3, 2, 1}},
+		{"[3::-1]", []int{3, slice.None, -1}, []int{4, 3, 2, 1}},
+		{"[:2:-1]", []int{slice.None, 2, -1}, []int{5, 4}},
+		{"[0:5:3]", []int{0, 5, 3}, []int{1, 4}},
+		{"[-2::]", []int{-2, slice.None, slice.None}, []int{4, 5}},
+		{"[5:0:-1]", []int{5, 0, -1}, []int{5, 4, 3, 2}},
+		{"[2:2]", []int{2, 2}, []int{}},
+		{"[0:0:-1]", []int{0, 0, -1}, []int{}},
+		{"step zero", []int{0, 5, 0}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := []int{1, 2, 3, 4, 5}
+			got := slice.PySlice(s, tt.indexes...)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PySlice(%v) = %v, want %v", tt.indexes, got, tt.want)
+			}
+			if !reflect.DeepEqual(s, []int{1, 2, 3, 4, 5}) {
+				t.Errorf("PySlice(%v) modified its input: %v", tt.indexes, s)
+			}
+		})
+	}
+}
+
+func TestPyFill(t *testing.T) {
+	tests := []struct {
+		name    string
+		indexes []int
+		want    []int
+	}{
+		{"all", []int{}, []int{0, 0, 0, 0, 0}},
+		{"negative start", []int{-2}, []int{1, 2, 3, 0, 0}},
+		{"range", []int{1, 3}, []int{1, 0, 0, 4, 5}},
+		{"negative step", []int{slice.None, slice.None, -2}, []int{0, 2, 0, 4, 0}},
+		{"step zero", []int{0, 5, 0}, []int{1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.PyFill([]int{1, 2, 3, 4, 5}, 0, tt.indexes...)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PyFill(%v) = %v, want %v", tt.indexes, got, tt.want)
+			}
+		})
+	}
+}
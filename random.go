@@ -0,0 +1,195 @@
+package slice
+
+import (
+	"iter"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// intN returns a random int in [0, n) from r, or from the global source if r is nil.
+func intN(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.IntN(n)
+	}
+	return r.IntN(n)
+}
+
+// float64N returns a random float64 in [0, 1) from r, or from the global source if r is nil.
+func float64N(r *rand.Rand) float64 {
+	if r == nil {
+		return rand.Float64()
+	}
+	return r.Float64()
+}
+
+// Shuffle randomly permutes the elements of the slice in place.
+//
+// Parameters:
+//   - s: The slice to shuffle.
+//   - r: The source of randomness, or nil to use the global source.
+//
+// Returns:
+//
+//	The shuffled slice.
+//
+// Example:
+//
+//	r := rand.New(rand.NewPCG(1, 2)) // deterministic
+//	Shuffle([]int{1, 2, 3, 4}, r)
+func Shuffle[T any](s []T, r *rand.Rand) []T {
+	for i := len(s) - 1; i > 0; i-- {
+		j := intN(r, i+1)
+		s[i], s[j] = s[j], s[i]
+	}
+	return s
+}
+
+// Sample returns n distinct elements of the slice chosen at random, without replacement.
+// The input is not modified.
+//
+// Parameters:
+//   - s: The slice to sample from.
+//   - n: The number of elements to choose. If n exceeds the length of s, every element is returned in random order.
+//   - r: The source of randomness, or nil to use the global source.
+//
+// Returns:
+//
+//	A new slice with the chosen elements.
+func Sample[T any](s []T, n int, r *rand.Rand) []T {
+	n = min(max(n, 0), len(s))
+	list := append([]T(nil), s...)
+	for i := 0; i < n; i++ {
+		j := i + intN(r, len(list)-i)
+		list[i], list[j] = list[j], list[i]
+	}
+	return list[:n:n]
+}
+
+// SampleWithReplacement returns n elements of the slice chosen independently at random,
+// so the same element may be chosen more than once.
+//
+// Parameters:
+//   - s: The slice to sample from.
+//   - n: The number of elements to choose.
+//   - r: The source of randomness, or nil to use the global source.
+//
+// Returns:
+//
+//	A new slice with the chosen elements, or nil if s is empty.
+func SampleWithReplacement[T any](s []T, n int, r *rand.Rand) []T {
+	if len(s) == 0 || n <= 0 {
+		return nil
+	}
+	list := make([]T, n)
+	for i := range list {
+		list[i] = s[intN(r, len(s))]
+	}
+	return list
+}
+
+// WeightedChoice chooses one element at random with probability proportional to its weight.
+//
+// Parameters:
+//   - s: The slice to choose from.
+//   - weight: A function that returns the weight of each element. Negative, NaN and infinite weights count as zero.
+//   - r: The source of randomness, or nil to use the global source.
+//
+// Returns:
+//
+//	The chosen element, and false if the slice is empty or all weights are zero.
+func WeightedChoice[T any](s []T, weight func(T) float64, r *rand.Rand) (T, bool) {
+	weights := Map(s, func(v T, _ int) float64 {
+		if w := weight(v); w > 0 && !math.IsInf(w, 1) {
+			return w
+		}
+		return 0
+	})
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	if math.IsInf(total, 1) {
+		// The weights are finite but their sum is not; scale them down so that it is.
+		top := slices.Max(weights)
+		total = 0
+		for i := range weights {
+			weights[i] /= top
+			total += weights[i]
+		}
+	}
+	if total <= 0 {
+		var zero T
+		return zero, false
+	}
+	target := float64N(r) * total
+	last := 0
+	for i, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return s[i], true
+		}
+		target -= w
+		last = i
+	}
+	// Rounding can leave a sliver of target; it belongs to the last weighted element.
+	return s[last], true
+}
+
+// StratifiedSample groups the elements by key and samples up to n elements from each group without replacement.
+// Groups appear in the order their keys are first seen; the input is not modified.
+//
+// Parameters:
+//   - s: The slice to sample from.
+//   - key: A function that returns the group of each element.
+//   - n: The number of elements to choose from each group.
+//   - r: The source of randomness, or nil to use the global source.
+//
+// Returns:
+//
+//	A new slice with the chosen elements, grouped by key.
+func StratifiedSample[T any, K comparable](s []T, key func(T) K, n int, r *rand.Rand) []T {
+	groups := MultiIndex(s, key)
+	list := make([]T, 0, len(s))
+	for _, v := range s {
+		k := key(v)
+		group, ok := groups[k]
+		if !ok {
+			continue
+		}
+		delete(groups, k)
+		list = append(list, Sample(group, n, r)...)
+	}
+	return list
+}
+
+// ReservoirSample chooses k elements uniformly at random from a sequence of unknown length in a single pass.
+//
+// Parameters:
+//   - seq: The sequence to sample from.
+//   - k: The number of elements to choose.
+//   - r: The source of randomness, or nil to use the global source.
+//
+// Returns:
+//
+//	A new slice with up to k chosen elements.
+func ReservoirSample[T any](seq iter.Seq[T], k int, r *rand.Rand) []T {
+	if k <= 0 {
+		return nil
+	}
+	reservoir := make([]T, 0, k)
+	seen := 0
+	for v := range seq {
+		seen++
+		if len(reservoir) < k {
+			reservoir = append(reservoir, v)
+			continue
+		}
+		if j := intN(r, seen); j < k {
+			reservoir[j] = v
+		}
+	}
+	return reservoir
+}
@@ -0,0 +1,174 @@
+package slice_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func seeded() *rand.Rand {
+	return rand.New(rand.NewPCG(1, 2))
+}
+
+func TestShuffle(t *testing.T) {
+	a := slice.Shuffle([]int{1, 2, 3, 4, 5, 6, 7, 8}, seeded())
+	b := slice.Shuffle([]int{1, 2, 3, 4, 5, 6, 7, 8}, seeded())
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Shuffle() with the same seed = %v and %v, want equal", a, b)
+	}
+	if sorted := slices.Sorted(slices.Values(a)); !reflect.DeepEqual(sorted, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("Shuffle() = %v, not a permutation", a)
+	}
+	if got := slice.Shuffle([]int(nil), nil); len(got) != 0 {
+		t.Errorf("Shuffle(nil) = %v", got)
+	}
+}
+
+func TestSample(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6}
+	tests := []struct {
+		name    string
+		n       int
+		wantLen int
+	}{
+		{"some", 3, 3},
+		{"all", 6, 6},
+		{"more than length", 10, 6},
+		{"negative", -1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.Sample(s, tt.n, seeded())
+			if len(got) != tt.wantLen || len(slice.Distinct(got)) != tt.wantLen || !slice.ContainsAll(s, got...) {
+				t.Errorf("Sample(%d) = %v", tt.n, got)
+			}
+		})
+	}
+	if !reflect.DeepEqual(s, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("Sample() modified input: %v", s)
+	}
+}
+
+func TestSampleWithReplacement(t *testing.T) {
+	got := slice.SampleWithReplacement([]string{"a", "b"}, 20, seeded())
+	if len(got) != 20 || !slice.ContainsAll([]string{"a", "b"}, got...) {
+		t.Errorf("SampleWithReplacement() = %v", got)
+	}
+	if got := slice.SampleWithReplacement([]int{}, 3, nil); got != nil {
+		t.Errorf("SampleWithReplacement(empty) = %v, want nil", got)
+	}
+}
+
+func TestWeightedChoice(t *testing.T) {
+	r := seeded()
+	weight := func(s string) float64 {
+		return map[string]float64{"never": 0, "rare": 1, "common": 9, "negative": -5, "nan": math.NaN(), "inf": math.Inf(1), "-inf": math.Inf(-1)}[s]
+	}
+	counts := map[string]int{}
+	for range 10000 {
+		v, ok := slice.WeightedChoice([]string{"never", "rare", "nan", "common", "inf", "negative", "-inf"}, weight, r)
+		if !ok {
+			t.Fatal("WeightedChoice() ok = false")
+		}
+		counts[v]++
+	}
+	if counts["never"] != 0 || counts["negative"] != 0 || counts["nan"] != 0 || counts["inf"] != 0 || counts["-inf"] != 0 {
+		t.Errorf("WeightedChoice() chose zero-weight elements: %v", counts)
+	}
+	if counts["common"] < 8500 || counts["common"] > 9500 {
+		t.Errorf("WeightedChoice() counts = %v, want about 9000 common", counts)
+	}
+	if _, ok := slice.WeightedChoice([]string{"never"}, weight, r); ok {
+		t.Error("WeightedChoice() with all-zero weights ok = true")
+	}
+	if _, ok := slice.WeightedChoice([]string{"nan", "inf"}, weight, r); ok {
+		t.Error("WeightedChoice() with only NaN and infinite weights ok = true")
+	}
+	huge := func(v int) float64 { return float64(v) * math.MaxFloat64 / 2 }
+	for range 100 {
+		if v, ok := slice.WeightedChoice([]int{0, 1, 2}, huge, r); !ok || v == 0 {
+			t.Fatalf("WeightedChoice() with overflowing total = %v, %v", v, ok)
+		}
+	}
+}
+
+func TestStratifiedSample(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+	got := slice.StratifiedSample(s, func(v int) int { return v % 3 }, 2, seeded())
+	if len(got) != 6 {
+		t.Fatalf("StratifiedSample() = %v, want 6 elements", got)
+	}
+	for i, want := range []int{1, 1, 2, 2, 0, 0} {
+		if got[i]%3 != want {
+			t.Errorf("StratifiedSample() = %v, element %d in group %d, want %d", got, i, got[i]%3, want)
+		}
+	}
+}
+
+func TestReservoirSample(t *testing.T) {
+	seq := slices.Values([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	got := slice.ReservoirSample(seq, 4, seeded())
+	if len(got) != 4 || len(slice.Distinct(got)) != 4 {
+		t.Errorf("ReservoirSample() = %v", got)
+	}
+	if !reflect.DeepEqual(got, slice.ReservoirSample(seq, 4, seeded())) {
+		t.Error("ReservoirSample() with the same seed differs")
+	}
+	if got := slice.ReservoirSample(slices.Values([]int{1, 2}), 5, nil); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("ReservoirSample(short) = %v", got)
+	}
+	// Every element should be picked roughly k/n of the time.
+	r := seeded()
+	counts := make([]int, 10)
+	for range 5000 {
+		for _, v := range slice.ReservoirSample(seq, 3, r) {
+			counts[v-1]++
+		}
+	}
+	for i, c := range counts {
+		if c < 1300 || c > 1700 {
+			t.Errorf("ReservoirSample() picked %d %d times, want about 1500", i+1, c)
+		}
+	}
+}
+
+func TestShuffleMethods(t *testing.T) {
+	impls := map[string]slice.IAdvancedSlice[int]{
+		"advanced":   slice.NewAdvancedSlice(1, 2, 3, 4, 5),
+		"deque":      slice.NewDeque(1, 2, 3, 4, 5),
+		"comparable": slice.NewComparableSlice(1, 2, 3, 4, 5),
+		"ordered":    slice.NewOrderedSlice(1, 2, 3, 4, 5),
+		"number":     slice.NewNumberSlice(1, 2, 3, 4, 5),
+		"bounded":    slice.NewBoundedSlice(5, slice.OverflowEvict, 1, 2, 3, 4, 5),
+		"indexed":    slice.NewIndexedSlice(func(v int) int { return v }, 1, 2, 3, 4, 5),
+	}
+	want := slice.Shuffle([]int{1, 2, 3, 4, 5}, seeded())
+	for name, s := range impls {
+		t.Run(name, func(t *testing.T) {
+			if got := s.Shuffle(seeded()); got != s {
+				t.Errorf("Shuffle() returned %T, want the receiver", got)
+			}
+			if !reflect.DeepEqual(s.Values(), want) {
+				t.Errorf("Shuffle() = %v, want %v", s.Values(), want)
+			}
+			if got := s.Sample(2, seeded()); len(got) != 2 {
+				t.Errorf("Sample() = %v", got)
+			}
+			if got := s.SampleWithReplacement(7, seeded()); len(got) != 7 {
+				t.Errorf("SampleWithReplacement() = %v", got)
+			}
+			if v, ok := s.WeightedChoice(func(v int) float64 { return float64(v / 5) }, nil); !ok || v != 5 {
+				t.Errorf("WeightedChoice() = %v, %v, want 5", v, ok)
+			}
+		})
+	}
+	indexed := slice.NewIndexedSlice(func(v int) int { return v }, 1, 2, 3, 4, 5)
+	indexed.Shuffle(seeded())
+	if i := indexed.IndexOfKey(want[0]); i != 0 {
+		t.Errorf("IndexOfKey() after Shuffle = %d, want 0", i)
+	}
+}
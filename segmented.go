@@ -0,0 +1,593 @@
+package slice
+
+import (
+	"iter"
+	"math/rand/v2"
+)
+
+// defaultChunkSize is the number of elements per chunk used when NewSegmentedSlice is given no size.
+const defaultChunkSize = 4096
+
+var _ IAdvancedSlice[any] = (*SegmentedSlice[any])(nil)
+
+// SegmentedSlice is an advanced slice that stores its elements in fixed-size chunks instead of one
+// contiguous array. Growing it allocates a new chunk rather than copying every element, so appends
+// to very large slices avoid the reallocation spikes of a flat slice, and Unshift and Shift are
+// amortized O(1) too. Indexing stays O(1). Values returns a flat copy.
+type SegmentedSlice[T any] struct {
+	// chunks all have length chunkSize; the elements start at chunks[0][head].
+	chunks    [][]T
+	chunkSize int
+	head      int
+	size      int
+	less      func(a, b T) bool
+	key       func(T) string
+	bounds    BoundsPolicy
+	err       error
+}
+
+// NewSegmentedSlice creates a new segmented slice.
+//
+// Parameters:
+//   - chunkSize: The number of elements per chunk. Values below 1 select a default of 4096.
+//   - data: The initial elements.
+//
+// Returns:
+//
+//   - *SegmentedSlice[T]: The segmented slice.
+//
+// Example:
+//
+//	events := NewSegmentedSlice[Event](0)
+//	for e := range stream {
+//		events.Push(e) // never copies the events already stored
+//	}
+func NewSegmentedSlice[T any](chunkSize int, data ...T) *SegmentedSlice[T] {
+	if chunkSize < 1 {
+		chunkSize = defaultChunkSize
+	}
+	s := &SegmentedSlice[T]{chunkSize: chunkSize}
+	s.Push(data...)
+	return s
+}
+
+// ptr returns a pointer to the element at a valid logical position.
+func (s *SegmentedSlice[T]) ptr(i int) *T {
+	p := s.head + i
+	return &s.chunks[p/s.chunkSize][p%s.chunkSize]
+}
+
+// segments returns an iterator over the occupied part of each chunk, front to back.
+func (s *SegmentedSlice[T]) segments() iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		start, left := s.head, s.size
+		for _, c := range s.chunks {
+			if left == 0 {
+				return
+			}
+			seg := c[start:min(len(c), start+left)]
+			if !yield(seg) {
+				return
+			}
+			left -= len(seg)
+			start = 0
+		}
+	}
+}
+
+// values returns the elements in order as a new slice.
+func (s *SegmentedSlice[T]) values() []T {
+	list := make([]T, 0, s.size)
+	for seg := range s.segments() {
+		list = append(list, seg...)
+	}
+	return list
+}
+
+// setValues replaces the contents, taking ownership of data. Whole chunks are carved out of data
+// without copying; only a partial final chunk is copied.
+func (s *SegmentedSlice[T]) setValues(data []T) {
+	n := s.chunkSize
+	full := len(data) / n
+	s.chunks = make([][]T, full, full+1)
+	for i := range s.chunks {
+		s.chunks[i] = data[i*n : (i+1)*n : (i+1)*n]
+	}
+	if rest := data[full*n:]; len(rest) > 0 {
+		c := make([]T, n)
+		copy(c, rest)
+		s.chunks = append(s.chunks, c)
+	}
+	s.head = 0
+	s.size = len(data)
+}
+
+// trim releases chunks that no longer hold elements, keeping one spare chunk at the back.
+func (s *SegmentedSlice[T]) trim() {
+	if s.size == 0 {
+		s.head = 0
+		if len(s.chunks) > 1 {
+			clear(s.chunks[1:])
+			s.chunks = s.chunks[:1]
+		}
+		return
+	}
+	if s.head >= s.chunkSize {
+		s.chunks[0] = nil
+		s.chunks = s.chunks[1:]
+		s.head -= s.chunkSize
+	}
+	used := (s.head + s.size + s.chunkSize - 1) / s.chunkSize
+	if len(s.chunks) > used+1 {
+		clear(s.chunks[used+1:])
+		s.chunks = s.chunks[:used+1]
+	}
+}
+
+// resolve applies the bounds policy to an index, recording an error under BoundsError.
+func (s *SegmentedSlice[T]) resolve(index int) (int, bool) {
+	i, ok := resolveIndex(s.size, index, s.bounds)
+	if !ok && s.bounds == BoundsError {
+		s.err = indexError(index, s.size)
+	}
+	return i, ok
+}
+
+// empty applies the bounds policy to a removal from an empty slice.
+func (s *SegmentedSlice[T]) empty() {
+	switch s.bounds {
+	case BoundsPanic:
+		panic(ErrEmpty)
+	case BoundsError:
+		s.err = ErrEmpty
+	}
+}
+
+// ChunkSize returns the number of elements per chunk.
+func (s *SegmentedSlice[T]) ChunkSize() int {
+	return s.chunkSize
+}
+
+// All returns an iterator over the indexes and elements, front to back.
+// It walks the chunks directly, without building a flat copy.
+//
+// Returns:
+//
+//   - iter.Seq2[int, T]: The iterator.
+func (s *SegmentedSlice[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for seg := range s.segments() {
+			for _, v := range seg {
+				if !yield(i, v) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
+
+// String returns a JSON string representation of the slice.
+func (s *SegmentedSlice[T]) String() string {
+	return String(s.values())
+}
+
+// Length returns the number of elements.
+func (s *SegmentedSlice[T]) Length() int {
+	return s.size
+}
+
+// Map replaces each element with the result of f.
+func (s *SegmentedSlice[T]) Map(f func(T, int) T) IAdvancedSlice[T] {
+	i := 0
+	for seg := range s.segments() {
+		for j := range seg {
+			seg[j] = f(seg[j], i)
+			i++
+		}
+	}
+	return s
+}
+
+// Unique keeps the first occurrence of each key returned by f, or by the configured key if f is nil.
+func (s *SegmentedSlice[T]) Unique(f func(T) string) IAdvancedSlice[T] {
+	if f == nil {
+		f = s.key
+	}
+	if f == nil {
+		return s
+	}
+	s.setValues(Unique(s.values(), f))
+	return s
+}
+
+// Concat appends the elements of the given slices.
+func (s *SegmentedSlice[T]) Concat(ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	return s.PushSlice(ss...)
+}
+
+// CopyWithIn keeps only the elements at the given indices.
+func (s *SegmentedSlice[T]) CopyWithIn(indexes ...int) IAdvancedSlice[T] {
+	resolved, err := resolveIndexes(s.size, indexes, s.bounds)
+	if err != nil && s.bounds == BoundsError {
+		s.err = err
+	}
+	list := make([]T, len(resolved))
+	for k, i := range resolved {
+		list[k] = *s.ptr(i)
+	}
+	s.setValues(list)
+	return s
+}
+
+// Every checks if all elements satisfy f.
+func (s *SegmentedSlice[T]) Every(f func(T) bool) bool {
+	return s.FindIndex(func(v T) bool { return !f(v) }) < 0
+}
+
+// Find returns the first element that satisfies f, or the zero value.
+func (s *SegmentedSlice[T]) Find(f func(T) bool) T {
+	if i := s.FindIndex(f); i >= 0 {
+		return *s.ptr(i)
+	}
+	var zero T
+	return zero
+}
+
+// FindIndex returns the index of the first element that satisfies f, or -1.
+func (s *SegmentedSlice[T]) FindIndex(f func(T) bool) int {
+	for i, v := range s.All() {
+		if f(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindLast returns the last element that satisfies f, or the zero value.
+func (s *SegmentedSlice[T]) FindLast(f func(T) bool) T {
+	if i := s.FindLastIndex(f); i >= 0 {
+		return *s.ptr(i)
+	}
+	var zero T
+	return zero
+}
+
+// FindLastIndex returns the index of the last element that satisfies f, or -1.
+func (s *SegmentedSlice[T]) FindLastIndex(f func(T) bool) int {
+	for i := s.size - 1; i >= 0; i-- {
+		if f(*s.ptr(i)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ForEach calls f for each element, front to back.
+func (s *SegmentedSlice[T]) ForEach(f func(T, int)) {
+	for i, v := range s.All() {
+		f(v, i)
+	}
+}
+
+// Join converts all elements to strings and joins them with an optional separator.
+func (s *SegmentedSlice[T]) Join(sep ...string) string {
+	return Join(s.values(), sep...)
+}
+
+// Slice keeps only the selected subset.
+func (s *SegmentedSlice[T]) Slice(index ...int) IAdvancedSlice[T] {
+	s.setValues(Slice(s.values(), index...))
+	return s
+}
+
+// Fill sets the selected elements to value.
+func (s *SegmentedSlice[T]) Fill(value T, index ...int) IAdvancedSlice[T] {
+	if err := checkRange(s.size, index, s.bounds); err != nil && s.bounds == BoundsError {
+		s.err = err
+	}
+	if len(index) == 0 {
+		return s.Map(func(T, int) T { return value })
+	}
+	s.setValues(Fill(s.values(), value, index...))
+	return s
+}
+
+// At returns the element at index, handling out-of-range indices according to the bounds policy.
+func (s *SegmentedSlice[T]) At(index int) T {
+	i, ok := s.resolve(index)
+	if !ok {
+		var zero T
+		return zero
+	}
+	return *s.ptr(i)
+}
+
+// Sort sorts the elements using f, or the configured comparator if f is nil.
+func (s *SegmentedSlice[T]) Sort(f func(T, T) bool) IAdvancedSlice[T] {
+	if f == nil {
+		f = s.less
+	}
+	if f == nil {
+		return s
+	}
+	s.setValues(Sort(s.values(), f))
+	return s
+}
+
+// Values returns the elements, front to back, as a new slice.
+func (s *SegmentedSlice[T]) Values() []T {
+	return s.values()
+}
+
+// Filter returns the elements that satisfy f.
+func (s *SegmentedSlice[T]) Filter(f func(T, int) bool) []T {
+	var list []T
+	for i, v := range s.All() {
+		if f(v, i) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// Pop removes and returns the last element.
+func (s *SegmentedSlice[T]) Pop() T {
+	v, ok := s.PopIs()
+	if !ok {
+		s.empty()
+	}
+	return v
+}
+
+// PopIs removes and returns the last element, and whether there was one.
+func (s *SegmentedSlice[T]) PopIs() (T, bool) {
+	var zero T
+	if s.size == 0 {
+		return zero, false
+	}
+	p := s.ptr(s.size - 1)
+	v := *p
+	*p = zero
+	s.size--
+	s.trim()
+	return v, true
+}
+
+// Push appends one or more elements, filling the last chunk and allocating new ones as needed.
+func (s *SegmentedSlice[T]) Push(values ...T) IAdvancedSlice[T] {
+	for len(values) > 0 {
+		p := s.head + s.size
+		c := p / s.chunkSize
+		if c == len(s.chunks) {
+			s.chunks = append(s.chunks, make([]T, s.chunkSize))
+		}
+		n := copy(s.chunks[c][p%s.chunkSize:], values)
+		values = values[n:]
+		s.size += n
+	}
+	return s
+}
+
+// PushSlice appends the elements of the given slices. Segmented slices are copied chunk by chunk
+// without being flattened first.
+func (s *SegmentedSlice[T]) PushSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	for _, v := range values {
+		other, ok := v.(*SegmentedSlice[T])
+		if !ok {
+			s.Push(v.Values()...)
+			continue
+		}
+		if other == s {
+			other = NewSegmentedSlice(s.chunkSize, s.values()...)
+		}
+		for seg := range other.segments() {
+			s.Push(seg...)
+		}
+	}
+	return s
+}
+
+// Shift removes and returns the first element.
+func (s *SegmentedSlice[T]) Shift() T {
+	v, ok := s.ShiftIs()
+	if !ok {
+		s.empty()
+	}
+	return v
+}
+
+// ShiftIs removes and returns the first element, and whether there was one.
+func (s *SegmentedSlice[T]) ShiftIs() (T, bool) {
+	var zero T
+	if s.size == 0 {
+		return zero, false
+	}
+	p := s.ptr(0)
+	v := *p
+	*p = zero
+	s.head++
+	s.size--
+	s.trim()
+	return v, true
+}
+
+// Unshift prepends one or more elements, keeping their order. New chunks are added at the front
+// as needed; existing elements never move.
+func (s *SegmentedSlice[T]) Unshift(values ...T) IAdvancedSlice[T] {
+	for len(values) > 0 {
+		if s.head == 0 {
+			s.chunks = append([][]T{make([]T, s.chunkSize)}, s.chunks...)
+			s.head = s.chunkSize
+		}
+		n := min(s.head, len(values))
+		copy(s.chunks[0][s.head-n:s.head], values[len(values)-n:])
+		values = values[:len(values)-n]
+		s.head -= n
+		s.size += n
+	}
+	return s
+}
+
+// UnshiftSlice prepends the elements of each given slice in turn.
+func (s *SegmentedSlice[T]) UnshiftSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	for _, v := range values {
+		s.Unshift(v.Values()...)
+	}
+	return s
+}
+
+// Reverse reverses the order of the elements.
+func (s *SegmentedSlice[T]) Reverse() IAdvancedSlice[T] {
+	for i, j := 0, s.size-1; i < j; i, j = i+1, j-1 {
+		a, b := s.ptr(i), s.ptr(j)
+		*a, *b = *b, *a
+	}
+	return s
+}
+
+// Shuffle randomly permutes the elements in place.
+func (s *SegmentedSlice[T]) Shuffle(r *rand.Rand) IAdvancedSlice[T] {
+	for i := s.size - 1; i > 0; i-- {
+		a, b := s.ptr(i), s.ptr(intN(r, i+1))
+		*a, *b = *b, *a
+	}
+	return s
+}
+
+// Sample returns n distinct elements chosen at random, without replacement.
+func (s *SegmentedSlice[T]) Sample(n int, r *rand.Rand) []T {
+	return Sample(s.values(), n, r)
+}
+
+// SampleWithReplacement returns n elements chosen independently at random.
+func (s *SegmentedSlice[T]) SampleWithReplacement(n int, r *rand.Rand) []T {
+	if s.size == 0 || n <= 0 {
+		return nil
+	}
+	list := make([]T, n)
+	for i := range list {
+		list[i] = *s.ptr(intN(r, s.size))
+	}
+	return list
+}
+
+// WeightedChoice chooses one element at random with probability proportional to its weight.
+func (s *SegmentedSlice[T]) WeightedChoice(weight func(T) float64, r *rand.Rand) (T, bool) {
+	return WeightedChoice(s.values(), weight, r)
+}
+
+// Remove removes the elements that satisfy f.
+func (s *SegmentedSlice[T]) Remove(f func(T, int) bool) IAdvancedSlice[T] {
+	s.setValues(Remove(s.values(), f))
+	return s
+}
+
+// RemoveAt removes the element at index, handling out-of-range indices according to the bounds policy.
+func (s *SegmentedSlice[T]) RemoveAt(index int) IAdvancedSlice[T] {
+	if i, ok := s.resolve(index); ok {
+		s.removeAt(i)
+	}
+	return s
+}
+
+// removeAt removes the element at a valid index, moving whichever side is shorter.
+func (s *SegmentedSlice[T]) removeAt(i int) {
+	if i < s.size/2 {
+		for j := i; j > 0; j-- {
+			*s.ptr(j) = *s.ptr(j - 1)
+		}
+		s.ShiftIs()
+		return
+	}
+	for j := i; j < s.size-1; j++ {
+		*s.ptr(j) = *s.ptr(j + 1)
+	}
+	s.PopIs()
+}
+
+// AtErr returns the element at index, or an error wrapping ErrIndexOutOfRange if the bounds policy does not clamp it.
+func (s *SegmentedSlice[T]) AtErr(index int) (T, error) {
+	i, err := resolveIndexErr(s.size, index, s.bounds)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return *s.ptr(i), nil
+}
+
+// RemoveAtErr removes the element at index, or returns an error wrapping ErrIndexOutOfRange if the bounds policy does not clamp it.
+func (s *SegmentedSlice[T]) RemoveAtErr(index int) (IAdvancedSlice[T], error) {
+	i, err := resolveIndexErr(s.size, index, s.bounds)
+	if err != nil {
+		return s, err
+	}
+	s.removeAt(i)
+	return s, nil
+}
+
+// PopErr removes and returns the last element, or returns ErrEmpty.
+func (s *SegmentedSlice[T]) PopErr() (T, error) {
+	v, ok := s.PopIs()
+	if !ok {
+		return v, ErrEmpty
+	}
+	return v, nil
+}
+
+// ShiftErr removes and returns the first element, or returns ErrEmpty.
+func (s *SegmentedSlice[T]) ShiftErr() (T, error) {
+	v, ok := s.ShiftIs()
+	if !ok {
+		return v, ErrEmpty
+	}
+	return v, nil
+}
+
+// SliceErr keeps only the selected subset, or leaves the slice unchanged and returns an error as SliceErr does.
+func (s *SegmentedSlice[T]) SliceErr(index ...int) (IAdvancedSlice[T], error) {
+	data, err := SliceErr(s.values(), index...)
+	if err != nil {
+		return s, err
+	}
+	s.setValues(data)
+	return s, nil
+}
+
+// SetBoundsPolicy sets how out-of-range indices and removals from an empty slice are handled.
+func (s *SegmentedSlice[T]) SetBoundsPolicy(policy BoundsPolicy) {
+	s.bounds = policy
+}
+
+// SetComparator sets the comparison function Sort uses when it is called with nil.
+func (s *SegmentedSlice[T]) SetComparator(less func(a, b T) bool) {
+	s.less = less
+}
+
+// SetKey sets the key function Unique uses when it is called with nil.
+func (s *SegmentedSlice[T]) SetKey(key func(T) string) {
+	s.key = key
+}
+
+// Err returns the last error recorded under the BoundsError policy and clears it.
+func (s *SegmentedSlice[T]) Err() error {
+	err := s.err
+	s.err = nil
+	return err
+}
+
+// Begin starts a transaction over the slice.
+func (s *SegmentedSlice[T]) Begin(validators ...Validator[T]) *Tx[T] {
+	return Begin[T](s, validators...)
+}
+
+// replace swaps in new contents.
+func (s *SegmentedSlice[T]) replace(values []T) {
+	s.setValues(values)
+}
+
+// config returns the comparator, key function and bounds policy.
+func (s *SegmentedSlice[T]) config() (func(a, b T) bool, func(T) string, BoundsPolicy) {
+	return s.less, s.key, s.bounds
+}
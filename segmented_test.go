@@ -0,0 +1,182 @@
+package slice_test
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+// TestSegmentedSliceMatchesAdvancedSlice applies the same random operations to a segmented slice
+// with tiny chunks and to a flat slice, so every chunk boundary case is crossed.
+func TestSegmentedSliceMatchesAdvancedSlice(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, chunk := range []int{1, 2, 3, 7} {
+		seg := slice.NewSegmentedSlice[int](chunk)
+		flat := slice.NewAdvancedSlice[int]()
+		for step := range 2000 {
+			switch op := r.IntN(9); op {
+			case 0, 1:
+				vs := []int{step, -step}[:1+r.IntN(2)]
+				seg.Push(vs...)
+				flat.Push(vs...)
+			case 2, 3:
+				vs := []int{step, -step, step * 2}[:1+r.IntN(3)]
+				seg.Unshift(vs...)
+				flat.Unshift(vs...)
+			case 4:
+				a, aok := seg.PopIs()
+				b, bok := flat.PopIs()
+				if a != b || aok != bok {
+					t.Fatalf("chunk %d step %d: PopIs() = %v, %v, want %v, %v", chunk, step, a, aok, b, bok)
+				}
+			case 5:
+				a, aok := seg.ShiftIs()
+				b, bok := flat.ShiftIs()
+				if a != b || aok != bok {
+					t.Fatalf("chunk %d step %d: ShiftIs() = %v, %v, want %v, %v", chunk, step, a, aok, b, bok)
+				}
+			case 6:
+				if n := flat.Length(); n > 0 {
+					i := r.IntN(n)
+					seg.RemoveAt(i)
+					flat.RemoveAt(i)
+				}
+			case 7:
+				seg.Reverse()
+				flat.Reverse()
+			case 8:
+				seg.Concat(slice.NewSegmentedSlice(chunk, step, step+1))
+				flat.Concat(slice.NewAdvancedSlice(step, step+1))
+			}
+			if got, want := seg.Values(), flat.Values(); !slices.Equal(got, want) {
+				t.Fatalf("chunk %d step %d: Values() = %v, want %v", chunk, step, got, want)
+			}
+		}
+	}
+}
+
+func TestSegmentedSliceAdvancedSlice(t *testing.T) {
+	var s slice.IAdvancedSlice[int] = slice.NewSegmentedSlice(2, 5, 3, 1, 4, 2)
+	s.Sort(func(a, b int) bool { return a < b })
+	if got, want := s.Values(), []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort() = %v, want %v", got, want)
+	}
+	s.RemoveAt(1).Map(func(v, _ int) int { return v * 10 })
+	if got, want := s.Values(), []int{10, 30, 40, 50}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RemoveAt().Map() = %v, want %v", got, want)
+	}
+	if i := s.FindLastIndex(func(v int) bool { return v < 40 }); i != 1 {
+		t.Errorf("FindLastIndex() = %d, want 1", i)
+	}
+	if got := s.Join(","); got != "10,30,40,50" {
+		t.Errorf("Join() = %v", got)
+	}
+	s.Concat(s)
+	if got, want := s.Values(), []int{10, 30, 40, 50, 10, 30, 40, 50}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Concat(self) = %v, want %v", got, want)
+	}
+	s.Fill(0, 1, 3)
+	if got, want := s.Values(), []int{10, 0, 0, 50, 10, 30, 40, 50}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Fill() = %v, want %v", got, want)
+	}
+	if _, err := s.AtErr(8); err == nil {
+		t.Error("AtErr(8) error = nil")
+	}
+	if v := s.At(7); v != 50 {
+		t.Errorf("At(7) = %v, want 50", v)
+	}
+}
+
+func TestSegmentedSliceAll(t *testing.T) {
+	s := slice.NewSegmentedSlice(3, 1, 2, 3, 4, 5)
+	s.Unshift(0)
+	var got []int
+	for i, v := range s.All() {
+		if i != v {
+			t.Errorf("All() yielded %d at index %d", v, i)
+		}
+		got = append(got, v)
+		if i == 3 {
+			break
+		}
+	}
+	if want := []int{0, 1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+}
+
+func TestSegmentedSliceTransaction(t *testing.T) {
+	s := slice.NewSegmentedSlice(2, 1, 2, 3)
+	tx := s.Begin()
+	tx.Shift()
+	tx.Push(4, 5)
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.Values(), []int{2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after Commit = %v, want %v", got, want)
+	}
+	s.Unshift(1)
+	if got, want := s.Values(), []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unshift() after Commit = %v, want %v", got, want)
+	}
+}
+
+const benchSize = 1 << 20
+
+func BenchmarkSegmentedSlicePush(b *testing.B) {
+	for b.Loop() {
+		s := slice.NewSegmentedSlice[int](0)
+		for i := range benchSize {
+			s.Push(i)
+		}
+	}
+}
+
+func BenchmarkAdvancedSlicePush(b *testing.B) {
+	for b.Loop() {
+		s := slice.NewAdvancedSlice[int]()
+		for i := range benchSize {
+			s.Push(i)
+		}
+	}
+}
+
+func BenchmarkSegmentedSliceUnshift(b *testing.B) {
+	s := slice.NewSegmentedSlice[int](0)
+	for i := 0; i < b.N; i++ {
+		s.Unshift(i)
+	}
+}
+
+func BenchmarkSegmentedSliceAll(b *testing.B) {
+	s := slice.NewSegmentedSlice[int](0, make([]int, benchSize)...)
+	for b.Loop() {
+		sum := 0
+		for _, v := range s.All() {
+			sum += v
+		}
+		_ = sum
+	}
+}
+
+func BenchmarkFlatSliceRange(b *testing.B) {
+	s := make([]int, benchSize)
+	for b.Loop() {
+		sum := 0
+		for _, v := range s {
+			sum += v
+		}
+		_ = sum
+	}
+}
+
+func BenchmarkSegmentedSliceConcat(b *testing.B) {
+	other := slice.NewSegmentedSlice[int](0, make([]int, benchSize)...)
+	for b.Loop() {
+		slice.NewSegmentedSlice[int](0).Concat(other)
+	}
+}
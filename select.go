@@ -0,0 +1,215 @@
+package slice
+
+import (
+	"math/bits"
+)
+
+// TopK returns the k largest elements of s, largest first, in O(n log k) using a bounded heap.
+// The selection is stable: among equal elements, those earlier in s are preferred and come first.
+// s is left unchanged.
+//
+// Parameters:
+//   - s: The slice to select from.
+//   - k: The number of elements to return. Values above len(s) return every element.
+//   - less: A function reporting whether a sorts before b, as in Sort.
+//
+// Returns:
+//
+//	A new slice with up to k elements in descending order, or nil if k is not positive.
+//
+// Example:
+//
+//	leaders := TopK(players, 10, func(a, b Player) bool { return a.Score < b.Score })
+func TopK[T any](s []T, k int, less func(a, b T) bool) []T {
+	return pick(s, topKIndexes(s, k, func(a, b T) bool { return less(b, a) }))
+}
+
+// BottomK returns the k smallest elements of s, smallest first, in O(n log k) using a bounded heap.
+// The selection is stable in the same way as TopK. s is left unchanged.
+//
+// Parameters:
+//   - s: The slice to select from.
+//   - k: The number of elements to return. Values above len(s) return every element.
+//   - less: A function reporting whether a sorts before b, as in Sort.
+//
+// Returns:
+//
+//	A new slice with up to k elements in ascending order, or nil if k is not positive.
+func BottomK[T any](s []T, k int, less func(a, b T) bool) []T {
+	return pick(s, topKIndexes(s, k, less))
+}
+
+// topKIndexes returns the indexes of the k elements that sort first under less, in sorted order
+// with ties broken by index. It keeps the k best seen so far in a heap whose top is the worst of them.
+func topKIndexes[T any](s []T, k int, less func(a, b T) bool) []int {
+	k = min(k, len(s))
+	if k <= 0 {
+		return nil
+	}
+	// worse reports whether index a sorts after index b.
+	worse := func(a, b int) bool {
+		switch {
+		case less(s[b], s[a]):
+			return true
+		case less(s[a], s[b]):
+			return false
+		}
+		return a > b
+	}
+	h := NewHeap(worse, identity(k)...)
+	for i := k; i < len(s); i++ {
+		if top, _ := h.Peek(); worse(top, i) {
+			h.Update(0, i)
+		}
+	}
+	idx := make([]int, k)
+	for i := k - 1; i >= 0; i-- {
+		idx[i] = h.Pop()
+	}
+	return idx
+}
+
+// pick returns the elements of s at the given indexes.
+func pick[T any](s []T, idx []int) []T {
+	if idx == nil {
+		return nil
+	}
+	list := make([]T, len(idx))
+	for i, j := range idx {
+		list[i] = s[j]
+	}
+	return list
+}
+
+// NthElement reorders s in place so that s[n] holds the element that would be there if s were sorted,
+// every element before it does not sort after it, and every element after it does not
+// sort before it. It runs quickselect with a three-way partition in O(n) on average;
+// if partitioning stops making progress it falls back to sorting the remaining range, bounding the
+// worst case at O(n log n). The reordering is not stable.
+//
+// Parameters:
+//   - s: The slice to reorder.
+//   - n: The sorted position to select.
+//   - less: A function reporting whether a sorts before b, as in Sort.
+//
+// Returns:
+//
+//	The element at sorted position n, or the zero value, leaving s unchanged, if n is out of range.
+//
+// Example:
+//
+//	median := NthElement(latencies, len(latencies)/2, func(a, b time.Duration) bool { return a < b })
+func NthElement[T any](s []T, n int, less func(a, b T) bool) T {
+	if n < 0 || n >= len(s) {
+		var zero T
+		return zero
+	}
+	lo, hi := 0, len(s)
+	budget := 2 * bits.Len(uint(len(s)))
+	for hi-lo > 1 {
+		if budget == 0 {
+			Sort(s[lo:hi], less)
+			break
+		}
+		budget--
+		lt, gt := partition3(s, lo, hi, less)
+		switch {
+		case n < lt:
+			hi = lt
+		case n >= gt:
+			lo = gt
+		default:
+			return s[n]
+		}
+	}
+	return s[n]
+}
+
+// partition3 partitions s[lo:hi] around a median-of-three pivot into elements less than,
+// equal to and greater than it, returning the bounds [lt, gt) of the equal range.
+func partition3[T any](s []T, lo, hi int, less func(a, b T) bool) (int, int) {
+	a, b, c := lo, lo+(hi-lo)/2, hi-1
+	if less(s[b], s[a]) {
+		a, b = b, a
+	}
+	if less(s[c], s[b]) {
+		b = c
+		if less(s[b], s[a]) {
+			b = a
+		}
+	}
+	pivot := s[b]
+	lt, i, gt := lo, lo, hi
+	for i < gt {
+		switch {
+		case less(s[i], pivot):
+			s[lt], s[i] = s[i], s[lt]
+			lt++
+			i++
+		case less(pivot, s[i]):
+			gt--
+			s[i], s[gt] = s[gt], s[i]
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+// PartialSort reorders s in place so that s[:k] holds the k smallest elements in sorted order.
+// The order of the remaining elements is unspecified. It costs O(n + k log k) on average
+// and is not stable; use PartialSortStable when equal elements must keep their order.
+//
+// Parameters:
+//   - s: The slice to reorder.
+//   - k: The number of leading elements to sort. Values above len(s) sort the whole slice.
+//   - less: A function reporting whether a sorts before b, as in Sort.
+//
+// Returns:
+//
+//	s, for chaining.
+//
+// Example:
+//
+//	PartialSort(entries, 10, byScoreDesc)[:10] // the first page of a leaderboard
+func PartialSort[T any](s []T, k int, less func(a, b T) bool) []T {
+	k = min(k, len(s))
+	if k <= 0 {
+		return s
+	}
+	NthElement(s, k-1, less)
+	Sort(s[:k], less)
+	return s
+}
+
+// PartialSortStable reorders s in place so that s[:k] holds the k smallest elements in stable
+// sorted order, followed by the remaining elements in their original order. It costs O(n log k)
+// and O(n) extra space.
+//
+// Parameters:
+//   - s: The slice to reorder.
+//   - k: The number of leading elements to sort. Values above len(s) sort the whole slice.
+//   - less: A function reporting whether a sorts before b, as in Sort.
+//
+// Returns:
+//
+//	s, for chaining.
+func PartialSortStable[T any](s []T, k int, less func(a, b T) bool) []T {
+	idx := topKIndexes(s, k, less)
+	if idx == nil {
+		return s
+	}
+	taken := make([]bool, len(s))
+	list := make([]T, 0, len(s))
+	for _, i := range idx {
+		taken[i] = true
+		list = append(list, s[i])
+	}
+	for i, v := range s {
+		if !taken[i] {
+			list = append(list, v)
+		}
+	}
+	copy(s, list)
+	return s
+}
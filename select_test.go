@@ -0,0 +1,163 @@
+package slice_test
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+// selectInputs returns slices covering the usual quickselect trouble spots.
+func selectInputs() map[string][]int {
+	r := rand.New(rand.NewPCG(7, 8))
+	random := make([]int, 1000)
+	dups := make([]int, 1000)
+	for i := range random {
+		random[i] = r.IntN(1 << 20)
+		dups[i] = r.IntN(5)
+	}
+	sorted := make([]int, 1000)
+	reversed := make([]int, 1000)
+	organ := make([]int, 1000)
+	for i := range sorted {
+		sorted[i] = i
+		reversed[i] = 1000 - i
+		organ[i] = min(i, 1000-i)
+	}
+	return map[string][]int{
+		"empty":    {},
+		"single":   {42},
+		"random":   random,
+		"dups":     dups,
+		"equal":    make([]int, 500),
+		"sorted":   sorted,
+		"reversed": reversed,
+		"organ":    organ,
+	}
+}
+
+func TestTopKBottomK(t *testing.T) {
+	for name, in := range selectInputs() {
+		orig := slices.Clone(in)
+		asc := slices.Sorted(slices.Values(in))
+		desc := slices.Clone(asc)
+		slices.Reverse(desc)
+		for _, k := range []int{-1, 0, 1, 10, len(in), len(in) + 5} {
+			n := min(max(k, 0), len(in))
+			wantTop, wantBottom := desc[:n], asc[:n]
+			if n == 0 {
+				wantTop, wantBottom = nil, nil
+			}
+			if got := slice.TopK(in, k, intLess); !slices.Equal(got, wantTop) {
+				t.Errorf("%s: TopK(%d) = %v, want %v", name, k, got, wantTop)
+			}
+			if got := slice.BottomK(in, k, intLess); !slices.Equal(got, wantBottom) {
+				t.Errorf("%s: BottomK(%d) = %v, want %v", name, k, got, wantBottom)
+			}
+		}
+		if !slices.Equal(in, orig) {
+			t.Errorf("%s: input modified", name)
+		}
+	}
+}
+
+func TestTopKStable(t *testing.T) {
+	type entry struct {
+		Score int
+		Name  string
+	}
+	in := []entry{{3, "a"}, {5, "b"}, {3, "c"}, {5, "d"}, {1, "e"}, {3, "f"}}
+	byScore := func(a, b entry) bool { return a.Score < b.Score }
+	if got, want := slice.TopK(in, 4, byScore), []entry{{5, "b"}, {5, "d"}, {3, "a"}, {3, "c"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TopK() = %v, want %v", got, want)
+	}
+	if got, want := slice.BottomK(in, 3, byScore), []entry{{1, "e"}, {3, "a"}, {3, "c"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("BottomK() = %v, want %v", got, want)
+	}
+	got := slice.PartialSortStable(slices.Clone(in), 3, byScore)
+	want := []entry{{1, "e"}, {3, "a"}, {3, "c"}, {5, "b"}, {5, "d"}, {3, "f"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PartialSortStable() = %v, want %v", got, want)
+	}
+}
+
+func TestNthElement(t *testing.T) {
+	for name, in := range selectInputs() {
+		sorted := slices.Sorted(slices.Values(in))
+		for _, n := range []int{0, len(in) / 3, len(in) / 2, len(in) - 1} {
+			if n < 0 || n >= len(in) {
+				continue
+			}
+			s := slices.Clone(in)
+			got := slice.NthElement(s, n, intLess)
+			if got != sorted[n] || s[n] != sorted[n] {
+				t.Fatalf("%s: NthElement(%d) = %v (s[n] = %v), want %v", name, n, got, s[n], sorted[n])
+			}
+			for i, v := range s {
+				if i < n && v > got || i > n && v < got {
+					t.Fatalf("%s: NthElement(%d) left %v at %d", name, n, v, i)
+				}
+			}
+		}
+	}
+	s := []int{3, 1, 2}
+	if got := slice.NthElement(s, 3, intLess); got != 0 || !slices.Equal(s, []int{3, 1, 2}) {
+		t.Errorf("NthElement() out of range = %v, %v", got, s)
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	for name, in := range selectInputs() {
+		sorted := slices.Sorted(slices.Values(in))
+		for _, k := range []int{0, 1, 10, len(in)} {
+			k = min(k, len(in))
+			s := slice.PartialSort(slices.Clone(in), k, intLess)
+			if !slices.Equal(s[:k], sorted[:k]) {
+				t.Errorf("%s: PartialSort(%d) prefix = %v, want %v", name, k, s[:k], sorted[:k])
+			}
+			if rest := slices.Sorted(slices.Values(s)); !slices.Equal(rest, sorted) {
+				t.Errorf("%s: PartialSort(%d) lost elements", name, k)
+			}
+		}
+	}
+}
+
+// scores returns n random leaderboard scores.
+func scores(n int) []int {
+	r := rand.New(rand.NewPCG(9, 10))
+	s := make([]int, n)
+	for i := range s {
+		s[i] = r.IntN(n)
+	}
+	return s
+}
+
+func BenchmarkTopK(b *testing.B) {
+	s := scores(1 << 20)
+	for b.Loop() {
+		slice.TopK(s, 10, intLess)
+	}
+}
+
+func BenchmarkSortThenSlice(b *testing.B) {
+	s := scores(1 << 20)
+	for b.Loop() {
+		slice.Slice(slice.Sort(slices.Clone(s), func(a, b int) bool { return a > b }), 0, 10)
+	}
+}
+
+func BenchmarkNthElement(b *testing.B) {
+	s := scores(1 << 20)
+	for b.Loop() {
+		slice.NthElement(slices.Clone(s), len(s)/2, intLess)
+	}
+}
+
+func BenchmarkPartialSort(b *testing.B) {
+	s := scores(1 << 20)
+	for b.Loop() {
+		slice.PartialSort(slices.Clone(s), 100, intLess)
+	}
+}
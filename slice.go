@@ -1,11 +1,47 @@
 package slice
 
+import "math/rand/v2"
+
 var _ IAdvancedSlice[any] = (*advancedSlice[any])(nil)
 
 // advancedSlice is a concrete implementation of the IAdvancedSlice interface.
 // It provides advanced functionality for manipulating slices.
 type advancedSlice[T any] struct {
 	data []T
+	// less is the default comparator used by Sort when it is called with nil.
+	less func(a, b T) bool
+	// key is the default key function used by Unique when it is called with nil.
+	key func(T) string
+	// bounds decides how out-of-range indices and removals from an empty slice are treated.
+	bounds BoundsPolicy
+	// err is the last error recorded under the BoundsError policy.
+	err error
+}
+
+// resolve applies the bounds policy to an index, recording an error under BoundsError.
+func (s *advancedSlice[T]) resolve(index int) (int, bool) {
+	i, ok := resolveIndex(len(s.data), index, s.bounds)
+	if !ok && s.bounds == BoundsError {
+		s.err = indexError(index, len(s.data))
+	}
+	return i, ok
+}
+
+// checkRange applies the bounds policy to the begin and end indices of a Fill, recording an error under BoundsError.
+func (s *advancedSlice[T]) checkRange(index []int) {
+	if err := checkRange(len(s.data), index, s.bounds); err != nil && s.bounds == BoundsError {
+		s.err = err
+	}
+}
+
+// empty applies the bounds policy to a removal from an empty slice.
+func (s *advancedSlice[T]) empty() {
+	switch s.bounds {
+	case BoundsPanic:
+		panic(ErrEmpty)
+	case BoundsError:
+		s.err = ErrEmpty
+	}
 }
 
 // String returns a string representation of the slice.
@@ -35,11 +71,18 @@ func (s *advancedSlice[T]) Map(f func(T, int) T) IAdvancedSlice[T] {
 // Parameters:
 //
 //   - f: A function that extracts a key from each element of type T. The key must be a string.
+//     If nil, the key function set by WithKey is used; with neither, the slice is left unchanged.
 //
 // Returns:
 //
 //   - IAdvancedSlice[T]: A new IAdvancedSlice[T] containing only the first occurrence of each unique key.
 func (s *advancedSlice[T]) Unique(f func(T) string) IAdvancedSlice[T] {
+	if f == nil {
+		f = s.key
+	}
+	if f == nil {
+		return s
+	}
 	s.data = Unique(s.data, f)
 	return s
 }
@@ -72,7 +115,11 @@ func (s *advancedSlice[T]) Concat(ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
 //
 //   - IAdvancedSlice[T]: A new IAdvancedSlice[T] containing elements at the specified indices.
 func (s *advancedSlice[T]) CopyWithIn(indexes ...int) IAdvancedSlice[T] {
-	s.data = CopyWithIn(s.data, indexes...)
+	resolved, err := resolveIndexes(len(s.data), indexes, s.bounds)
+	if err != nil && s.bounds == BoundsError {
+		s.err = err
+	}
+	s.data = CopyWithIn(s.data, resolved...)
 	return s
 }
 
@@ -178,31 +225,39 @@ func (s *advancedSlice[T]) Slice(index ...int) IAdvancedSlice[T] {
 }
 
 // Fill sets all elements of the slice to a specified value, optionally at specified indices.
+// A begin or end beyond either end of the slice is clamped, and handled according to the slice's BoundsPolicy.
 //
 // Parameters:
 //
 //   - value: The value to set.
-//   - indexes: An optional variadic parameter specifying the indices to fill.
+//   - indexes: An optional variadic parameter specifying the begin, end and step indices.
 //
 // Returns:
 //
 //   - IAdvancedSlice[T]: A new IAdvancedSlice[T] with the specified elements filled.
 func (s *advancedSlice[T]) Fill(value T, index ...int) IAdvancedSlice[T] {
-	s.data = Fill(s.data, value, index...)
+	s.checkRange(index)
+	Fill(s.data, value, index...)
 	return s
 }
 
 // At returns the element at the specified index in the slice.
+// Out-of-range indices are handled according to the slice's BoundsPolicy.
 //
 // Parameters:
 //
-//   - index: The index of the element to retrieve.
+//   - index: The index of the element to retrieve. A negative index counts from the end.
 //
 // Returns:
 //
 //   - T: The element at the specified index.
 func (s *advancedSlice[T]) At(index int) T {
-	return At(s.data, index)
+	i, ok := s.resolve(index)
+	if !ok {
+		var zero T
+		return zero
+	}
+	return s.data[i]
 }
 
 // Sort sorts the slice based on a comparison function.
@@ -210,11 +265,18 @@ func (s *advancedSlice[T]) At(index int) T {
 // Parameters:
 //
 //   - f: A comparison function that determines the order of elements.
+//     If nil, the comparator set by WithComparator is used; with neither, the slice is left unchanged.
 //
 // Returns:
 //
 //   - IAdvancedSlice[T]: A new IAdvancedSlice[T] containing the sorted elements.
 func (s *advancedSlice[T]) Sort(f func(T, T) bool) IAdvancedSlice[T] {
+	if f == nil {
+		f = s.less
+	}
+	if f == nil {
+		return s
+	}
 	s.data = Sort(s.data, f)
 	return s
 }
@@ -258,6 +320,7 @@ func (s *advancedSlice[T]) Filter(f func(T, int) bool) []T {
 func (s *advancedSlice[T]) Pop() T {
 	length := s.Length()
 	if length == 0 {
+		s.empty()
 		var zero T
 		return zero
 	}
@@ -323,6 +386,7 @@ func (s *advancedSlice[T]) PushSlice(values ...IAdvancedSlice[T]) IAdvancedSlice
 func (s *advancedSlice[T]) Shift() T {
 	length := s.Length()
 	if length == 0 {
+		s.empty()
 		var zero T
 		return zero
 	}
@@ -390,6 +454,59 @@ func (s *advancedSlice[T]) Reverse() IAdvancedSlice[T] {
 	return s
 }
 
+// Shuffle randomly permutes the elements of the slice in place.
+//
+// Parameters:
+//   - r: The source of randomness, or nil to use the global source.
+//
+// Returns:
+//
+//   - IAdvancedSlice[T]: The shuffled IAdvancedSlice[T].
+func (s *advancedSlice[T]) Shuffle(r *rand.Rand) IAdvancedSlice[T] {
+	Shuffle(s.data, r)
+	return s
+}
+
+// Sample returns n distinct elements chosen at random, without replacement.
+//
+// Parameters:
+//   - n: The number of elements to choose, capped at the length of the slice.
+//   - r: The source of randomness, or nil to use the global source.
+//
+// Returns:
+//
+//   - []T: A new slice with the chosen elements.
+func (s *advancedSlice[T]) Sample(n int, r *rand.Rand) []T {
+	return Sample(s.data, n, r)
+}
+
+// SampleWithReplacement returns n elements chosen independently at random.
+//
+// Parameters:
+//   - n: The number of elements to choose.
+//   - r: The source of randomness, or nil to use the global source.
+//
+// Returns:
+//
+//   - []T: A new slice with the chosen elements.
+func (s *advancedSlice[T]) SampleWithReplacement(n int, r *rand.Rand) []T {
+	return SampleWithReplacement(s.data, n, r)
+}
+
+// WeightedChoice chooses one element at random with probability proportional to its weight.
+//
+// Parameters:
+//   - weight: A function that returns the weight of each element.
+//   - r: The source of randomness, or nil to use the global source.
+//
+// Returns:
+//
+//   - T: The chosen element.
+//   - bool: False if the slice is empty or all weights are zero.
+func (s *advancedSlice[T]) WeightedChoice(weight func(T) float64, r *rand.Rand) (T, bool) {
+	return WeightedChoice(s.data, weight, r)
+}
+
 // Remove removes elements from the slice based on a predicate function.
 //
 // Parameters:
@@ -404,15 +521,163 @@ func (s *advancedSlice[T]) Remove(f func(T, int) bool) IAdvancedSlice[T] {
 }
 
 // RemoveAt removes an element at the specified index from the slice.
+// Out-of-range indices are handled according to the slice's BoundsPolicy.
 //
 // Parameters:
 //
-//   - index: The index of the element to remove.
+//   - index: The index of the element to remove. A negative index counts from the end.
 //
 // Returns:
 //
 //   - IAdvancedSlice[T]: A new IAdvancedSlice[T] with the removed element.
 func (s *advancedSlice[T]) RemoveAt(index int) IAdvancedSlice[T] {
-	s.data = RemoveAt(s.data, index)
+	if i, ok := s.resolve(index); ok {
+		s.data = RemoveAt(s.data, i)
+	}
 	return s
 }
+
+// AtErr returns the element at the specified index in the slice.
+//
+// Parameters:
+//
+//   - index: The index of the element to retrieve. A negative index counts from the end.
+//
+// Returns:
+//
+//   - T: The element at the specified index.
+//   - error: An error wrapping ErrIndexOutOfRange if the index is out of range and the bounds policy does not clamp it.
+func (s *advancedSlice[T]) AtErr(index int) (T, error) {
+	i, err := resolveIndexErr(len(s.data), index, s.bounds)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return s.data[i], nil
+}
+
+// RemoveAtErr removes an element at the specified index from the slice.
+//
+// Parameters:
+//
+//   - index: The index of the element to remove. A negative index counts from the end.
+//
+// Returns:
+//
+//   - IAdvancedSlice[T]: The updated slice.
+//   - error: An error wrapping ErrIndexOutOfRange if the index is out of range and the bounds policy does not clamp it.
+func (s *advancedSlice[T]) RemoveAtErr(index int) (IAdvancedSlice[T], error) {
+	i, err := resolveIndexErr(len(s.data), index, s.bounds)
+	if err != nil {
+		return s, err
+	}
+	s.data = RemoveAt(s.data, i)
+	return s, nil
+}
+
+// PopErr removes and returns the last element from the slice.
+//
+// Returns:
+//
+//   - T: The last element of the slice.
+//   - error: ErrEmpty if the slice is empty.
+func (s *advancedSlice[T]) PopErr() (T, error) {
+	v, ok := s.PopIs()
+	if !ok {
+		return v, ErrEmpty
+	}
+	return v, nil
+}
+
+// ShiftErr removes and returns the first element from the slice.
+//
+// Returns:
+//
+//   - T: The first element of the slice.
+//   - error: ErrEmpty if the slice is empty.
+func (s *advancedSlice[T]) ShiftErr() (T, error) {
+	v, ok := s.ShiftIs()
+	if !ok {
+		return v, ErrEmpty
+	}
+	return v, nil
+}
+
+// SliceErr is like Slice but reports invalid steps and out-of-range begin indices.
+//
+// Parameters:
+//
+//   - index: A variadic parameter specifying the begin and optionally end and step indices.
+//
+// Returns:
+//
+//   - IAdvancedSlice[T]: The updated slice, unchanged if an error is returned.
+//   - error: An error wrapping ErrInvalidStep or ErrIndexOutOfRange.
+func (s *advancedSlice[T]) SliceErr(index ...int) (IAdvancedSlice[T], error) {
+	data, err := SliceErr(s.data, index...)
+	if err != nil {
+		return s, err
+	}
+	s.data = data
+	return s, nil
+}
+
+// SetBoundsPolicy sets how out-of-range indices and removals from an empty slice are handled.
+//
+// Parameters:
+//
+//   - policy: The bounds policy.
+func (s *advancedSlice[T]) SetBoundsPolicy(policy BoundsPolicy) {
+	s.bounds = policy
+}
+
+// SetComparator sets the comparison function Sort uses when it is called with nil.
+//
+// Parameters:
+//
+//   - less: A comparison function that determines the order of elements.
+func (s *advancedSlice[T]) SetComparator(less func(a, b T) bool) {
+	s.less = less
+}
+
+// SetKey sets the key function Unique uses when it is called with nil.
+//
+// Parameters:
+//
+//   - key: A function that extracts a key from each element.
+func (s *advancedSlice[T]) SetKey(key func(T) string) {
+	s.key = key
+}
+
+// Err returns the last error recorded under the BoundsError policy and clears it.
+//
+// Returns:
+//
+//   - error: The recorded error, or nil.
+func (s *advancedSlice[T]) Err() error {
+	err := s.err
+	s.err = nil
+	return err
+}
+
+// Begin starts a transaction over the slice.
+//
+// Parameters:
+//   - validators: Invariants checked against the transaction's elements at commit time.
+//
+// Returns:
+//
+//   - *Tx[T]: The transaction.
+func (s *advancedSlice[T]) Begin(validators ...Validator[T]) *Tx[T] {
+	return Begin[T](s, validators...)
+}
+
+// replace swaps in new contents.
+func (s *advancedSlice[T]) replace(values []T) {
+	s.data = values
+}
+
+// config returns the comparator, key function and bounds policy.
+func (s *advancedSlice[T]) config() (func(a, b T) bool, func(T) string, BoundsPolicy) {
+	return s.less, s.key, s.bounds
+}
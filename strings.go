@@ -0,0 +1,629 @@
+package slice
+
+import (
+	"encoding/csv"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ContainsFold reports whether a string equal to v under Unicode case folding is present in the slice.
+//
+// Parameters:
+//   - s: The slice to search.
+//   - v: The string to look for.
+//
+// Returns:
+//
+//	true if the slice contains v ignoring case, false otherwise.
+func ContainsFold(s []string, v string) bool {
+	return IndexOfFold(s, v) >= 0
+}
+
+// IndexOfFold returns the index of the first string equal to v under Unicode case folding.
+//
+// Parameters:
+//   - s: The slice to search.
+//   - v: The string to look for.
+//
+// Returns:
+//
+//	The index of the first match, or -1 if there is none.
+func IndexOfFold(s []string, v string) int {
+	for i, item := range s {
+		if strings.EqualFold(item, v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// foldKey maps s to a key that is the same for two strings exactly when strings.EqualFold reports them equal.
+func foldKey(s string) string {
+	return strings.Map(foldRune, s)
+}
+
+// foldRune maps r to a fixed member of its simple case folding orbit, preferring the lower-case one.
+func foldRune(r rune) rune {
+	first := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		first = min(first, f)
+	}
+	lower := unicode.ToLower(first)
+	for f := unicode.SimpleFold(first); f != first; f = unicode.SimpleFold(f) {
+		if f == lower {
+			return lower
+		}
+	}
+	return first
+}
+
+// UniqueFold keeps the first of each group of strings that are equal under Unicode case folding,
+// the same rule ContainsFold and IndexOfFold use.
+//
+// Parameters:
+//   - s: The slice to deduplicate.
+//
+// Returns:
+//
+//	A new slice without case-insensitive duplicates.
+//
+// Example:
+//
+//	UniqueFold([]string{"Go", "go", "GO", "Rust"}) // ["Go", "Rust"]
+func UniqueFold(s []string) []string {
+	return Unique(s, foldKey)
+}
+
+// SortFold sorts the slice in place under Unicode case folding. Strings that are equal under case folding are ordered
+// by their exact value, so the result does not depend on the input order.
+//
+// Parameters:
+//   - s: The slice to sort.
+//
+// Returns:
+//
+//	The sorted slice.
+func SortFold(s []string) []string {
+	keys := make(map[string]string, len(s))
+	for _, v := range s {
+		keys[v] = foldKey(v)
+	}
+	sort.Slice(s, func(i, j int) bool {
+		a, b := keys[s[i]], keys[s[j]]
+		if a != b {
+			return a < b
+		}
+		return s[i] < s[j]
+	})
+	return s
+}
+
+// TrimAll removes leading and trailing white space from every string.
+//
+// Parameters:
+//   - s: The slice to modify.
+//
+// Returns:
+//
+//	The modified slice.
+func TrimAll(s []string) []string {
+	for i, v := range s {
+		s[i] = strings.TrimSpace(v)
+	}
+	return s
+}
+
+// FilterEmpty returns the non-empty strings. Combine it with TrimAll to also drop strings made only of white space.
+//
+// Parameters:
+//   - s: The slice to filter.
+//
+// Returns:
+//
+//	A new slice without empty strings.
+func FilterEmpty(s []string) []string {
+	list := make([]string, 0, len(s))
+	for _, v := range s {
+		if v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// JoinQuoted quotes every string as a Go string literal and joins them with sep.
+//
+// Parameters:
+//   - s: The slice to join.
+//   - sep: The separator.
+//
+// Returns:
+//
+//	The joined string.
+//
+// Example:
+//
+//	JoinQuoted([]string{"a", `b"c`}, ", ") // `"a", "b\"c"`
+func JoinQuoted(s []string, sep string) string {
+	quoted := make([]string, len(s))
+	for i, v := range s {
+		quoted[i] = strconv.Quote(v)
+	}
+	return strings.Join(quoted, sep)
+}
+
+// JoinEscaped joins the strings with sep after escaping every backslash and every occurrence of sep
+// with a backslash, so that SplitEscaped can recover the original slice.
+//
+// Parameters:
+//   - s: The slice to join.
+//   - sep: The separator, which must not be empty.
+//
+// Returns:
+//
+//	The joined string.
+//
+// Example:
+//
+//	JoinEscaped([]string{"a,b", "c"}, ",") // `a\,b,c`
+func JoinEscaped(s []string, sep string) string {
+	r := strings.NewReplacer(`\`, `\\`, sep, `\`+sep)
+	escaped := make([]string, len(s))
+	for i, v := range s {
+		escaped[i] = r.Replace(v)
+	}
+	return strings.Join(escaped, sep)
+}
+
+// SplitEscaped splits a string produced by JoinEscaped back into its parts.
+//
+// Parameters:
+//   - joined: The joined string.
+//   - sep: The separator that was passed to JoinEscaped.
+//
+// Returns:
+//
+//	The original strings, or nil if joined is empty.
+func SplitEscaped(joined, sep string) []string {
+	if joined == "" {
+		return nil
+	}
+	var list []string
+	var part strings.Builder
+	for i := 0; i < len(joined); {
+		switch {
+		case joined[i] == '\\' && i+1 < len(joined):
+			_, size := utf8.DecodeRuneInString(joined[i+1:])
+			if strings.HasPrefix(joined[i+1:], sep) {
+				size = len(sep)
+			}
+			part.WriteString(joined[i+1 : i+1+size])
+			i += 1 + size
+		case strings.HasPrefix(joined[i:], sep):
+			list = append(list, part.String())
+			part.Reset()
+			i += len(sep)
+		default:
+			part.WriteByte(joined[i])
+			i++
+		}
+	}
+	return append(list, part.String())
+}
+
+// JoinCSV joins the strings as a single CSV record, quoting fields that contain commas, quotes or newlines.
+//
+// Parameters:
+//   - s: The slice to join.
+//
+// Returns:
+//
+//	The CSV record, without a trailing newline.
+//
+// Example:
+//
+//	JoinCSV([]string{"a", "b,c", `say "hi"`}) // `a,"b,c","say ""hi"""`
+func JoinCSV(s []string) string {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	w.Write(s)
+	w.Flush()
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+var _ IAdvancedSlice[string] = (*StringSlice)(nil)
+
+// StringSlice is an advanced slice of strings with case-insensitive lookups, clean-up helpers,
+// quoting and escaping Join variants, and an optional trie index for prefix lookups.
+type StringSlice struct {
+	*advancedSlice[string]
+	// indexed reports whether the prefix index is enabled; trie is nil whenever it is out of date.
+	indexed bool
+	trie    *trieNode
+}
+
+// NewStringSlice creates a new string slice.
+//
+// Parameters:
+//   - data: The initial elements.
+//
+// Returns:
+//
+//   - *StringSlice: The string slice.
+//
+// Example:
+//
+//	s := NewStringSlice(" Go ", "go", "", "Rust")
+//	s.TrimAll().FilterEmpty()
+//	s.ContainsFold("GO") // true
+func NewStringSlice(data ...string) *StringSlice {
+	return &StringSlice{advancedSlice: &advancedSlice[string]{data: data}}
+}
+
+// trieNode is a node of the prefix index. idx lists the positions of the strings ending at the node.
+type trieNode struct {
+	children map[rune]*trieNode
+	idx      []int
+}
+
+// insert adds the string at position i below the node.
+func (n *trieNode) insert(v string, i int) {
+	for _, r := range v {
+		child, ok := n.children[r]
+		if !ok {
+			child = &trieNode{}
+			if n.children == nil {
+				n.children = make(map[rune]*trieNode)
+			}
+			n.children[r] = child
+		}
+		n = child
+	}
+	n.idx = append(n.idx, i)
+}
+
+// find returns the node reached by following prefix, or nil.
+func (n *trieNode) find(prefix string) *trieNode {
+	for _, r := range prefix {
+		if n = n.children[r]; n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// collect appends the positions of every string at or below the node.
+func (n *trieNode) collect(idx []int) []int {
+	idx = append(idx, n.idx...)
+	for _, child := range n.children {
+		idx = child.collect(idx)
+	}
+	return idx
+}
+
+// IndexPrefixes enables a trie index that makes HasPrefix and WithPrefix cost O(len(prefix)) plus
+// the size of the result. The index is updated in place by Push and rebuilt lazily after other changes.
+//
+// Returns:
+//
+//   - *StringSlice: The string slice, for chaining.
+func (s *StringSlice) IndexPrefixes() *StringSlice {
+	s.indexed = true
+	s.trie = nil
+	return s
+}
+
+// invalidate marks the prefix index as out of date.
+func (s *StringSlice) invalidate() {
+	s.trie = nil
+}
+
+// index returns the prefix index, rebuilding it if needed, or nil if it is not enabled.
+func (s *StringSlice) index() *trieNode {
+	if !s.indexed {
+		return nil
+	}
+	if s.trie == nil {
+		s.trie = &trieNode{}
+		for i, v := range s.data {
+			s.trie.insert(v, i)
+		}
+	}
+	return s.trie
+}
+
+// HasPrefix reports whether any string starts with prefix.
+func (s *StringSlice) HasPrefix(prefix string) bool {
+	if prefix == "" {
+		return len(s.data) > 0
+	}
+	if t := s.index(); t != nil {
+		return t.find(prefix) != nil
+	}
+	return slices.ContainsFunc(s.data, func(v string) bool { return strings.HasPrefix(v, prefix) })
+}
+
+// WithPrefix returns the strings that start with prefix, in slice order, for example to offer autocomplete suggestions.
+func (s *StringSlice) WithPrefix(prefix string) []string {
+	var list []string
+	t := s.index()
+	if t == nil {
+		for _, v := range s.data {
+			if strings.HasPrefix(v, prefix) {
+				list = append(list, v)
+			}
+		}
+		return list
+	}
+	node := t.find(prefix)
+	if node == nil {
+		return nil
+	}
+	idx := node.collect(nil)
+	slices.Sort(idx)
+	list = make([]string, len(idx))
+	for i, j := range idx {
+		list[i] = s.data[j]
+	}
+	return list
+}
+
+// Contains reports whether the string is present.
+func (s *StringSlice) Contains(v string) bool {
+	return Contains(s.data, v)
+}
+
+// IndexOf returns the index of the first occurrence of the string, or -1.
+func (s *StringSlice) IndexOf(v string) int {
+	return IndexOf(s.data, v)
+}
+
+// ContainsFold reports whether the string is present, ignoring case.
+func (s *StringSlice) ContainsFold(v string) bool {
+	return ContainsFold(s.data, v)
+}
+
+// IndexOfFold returns the index of the first string equal to v ignoring case, or -1.
+func (s *StringSlice) IndexOfFold(v string) int {
+	return IndexOfFold(s.data, v)
+}
+
+// UniqueFold keeps the first of each group of strings that are equal ignoring case.
+func (s *StringSlice) UniqueFold() *StringSlice {
+	s.data = UniqueFold(s.data)
+	s.invalidate()
+	return s
+}
+
+// SortFold sorts the strings ignoring case.
+func (s *StringSlice) SortFold() *StringSlice {
+	SortFold(s.data)
+	s.invalidate()
+	return s
+}
+
+// TrimAll removes leading and trailing white space from every string.
+func (s *StringSlice) TrimAll() *StringSlice {
+	TrimAll(s.data)
+	s.invalidate()
+	return s
+}
+
+// FilterEmpty removes the empty strings.
+func (s *StringSlice) FilterEmpty() *StringSlice {
+	s.data = FilterEmpty(s.data)
+	s.invalidate()
+	return s
+}
+
+// JoinQuoted quotes every string as a Go string literal and joins them with sep.
+func (s *StringSlice) JoinQuoted(sep string) string {
+	return JoinQuoted(s.data, sep)
+}
+
+// JoinEscaped joins the strings with sep, escaping sep and backslashes so that SplitEscaped can undo it.
+func (s *StringSlice) JoinEscaped(sep string) string {
+	return JoinEscaped(s.data, sep)
+}
+
+// JoinCSV joins the strings as a single CSV record.
+func (s *StringSlice) JoinCSV() string {
+	return JoinCSV(s.data)
+}
+
+// Map replaces each element with the result of f.
+func (s *StringSlice) Map(f func(string, int) string) IAdvancedSlice[string] {
+	s.advancedSlice.Map(f)
+	s.invalidate()
+	return s
+}
+
+// Unique keeps the first occurrence of each key returned by f.
+// If f is nil the strings themselves are compared.
+func (s *StringSlice) Unique(f func(string) string) IAdvancedSlice[string] {
+	if f == nil && s.key == nil {
+		s.data = Distinct(s.data)
+	} else {
+		s.advancedSlice.Unique(f)
+	}
+	s.invalidate()
+	return s
+}
+
+// Concat appends the elements of the given slices, updating the prefix index in place.
+func (s *StringSlice) Concat(ss ...IAdvancedSlice[string]) IAdvancedSlice[string] {
+	for _, v := range ss {
+		s.Push(v.Values()...)
+	}
+	return s
+}
+
+// CopyWithIn keeps only the elements at the given indices.
+func (s *StringSlice) CopyWithIn(indexes ...int) IAdvancedSlice[string] {
+	s.advancedSlice.CopyWithIn(indexes...)
+	s.invalidate()
+	return s
+}
+
+// Slice keeps only the selected subset.
+func (s *StringSlice) Slice(index ...int) IAdvancedSlice[string] {
+	s.advancedSlice.Slice(index...)
+	s.invalidate()
+	return s
+}
+
+// Fill sets the selected elements to value.
+func (s *StringSlice) Fill(value string, index ...int) IAdvancedSlice[string] {
+	s.advancedSlice.Fill(value, index...)
+	s.invalidate()
+	return s
+}
+
+// Sort sorts the elements using f.
+func (s *StringSlice) Sort(f func(string, string) bool) IAdvancedSlice[string] {
+	s.advancedSlice.Sort(f)
+	s.invalidate()
+	return s
+}
+
+// Pop removes and returns the last element.
+func (s *StringSlice) Pop() string {
+	v, ok := s.PopIs()
+	if !ok {
+		s.empty()
+	}
+	return v
+}
+
+// PopIs removes and returns the last element, and whether there was one.
+func (s *StringSlice) PopIs() (string, bool) {
+	v, ok := s.advancedSlice.PopIs()
+	if ok {
+		s.invalidate()
+	}
+	return v, ok
+}
+
+// PopErr removes and returns the last element, or returns ErrEmpty.
+func (s *StringSlice) PopErr() (string, error) {
+	v, err := s.advancedSlice.PopErr()
+	if err == nil {
+		s.invalidate()
+	}
+	return v, err
+}
+
+// Push appends one or more elements, updating the prefix index in place.
+func (s *StringSlice) Push(values ...string) IAdvancedSlice[string] {
+	if s.trie != nil {
+		for i, v := range values {
+			s.trie.insert(v, len(s.data)+i)
+		}
+	}
+	s.data = append(s.data, values...)
+	return s
+}
+
+// PushSlice appends the elements of the given slices, updating the prefix index in place.
+func (s *StringSlice) PushSlice(values ...IAdvancedSlice[string]) IAdvancedSlice[string] {
+	return s.Concat(values...)
+}
+
+// Shift removes and returns the first element.
+func (s *StringSlice) Shift() string {
+	v, ok := s.ShiftIs()
+	if !ok {
+		s.empty()
+	}
+	return v
+}
+
+// ShiftIs removes and returns the first element, and whether there was one.
+func (s *StringSlice) ShiftIs() (string, bool) {
+	v, ok := s.advancedSlice.ShiftIs()
+	if ok {
+		s.invalidate()
+	}
+	return v, ok
+}
+
+// ShiftErr removes and returns the first element, or returns ErrEmpty.
+func (s *StringSlice) ShiftErr() (string, error) {
+	v, err := s.advancedSlice.ShiftErr()
+	if err == nil {
+		s.invalidate()
+	}
+	return v, err
+}
+
+// Unshift prepends one or more elements.
+func (s *StringSlice) Unshift(values ...string) IAdvancedSlice[string] {
+	s.advancedSlice.Unshift(values...)
+	s.invalidate()
+	return s
+}
+
+// UnshiftSlice prepends the elements of each given slice in turn.
+func (s *StringSlice) UnshiftSlice(values ...IAdvancedSlice[string]) IAdvancedSlice[string] {
+	s.advancedSlice.UnshiftSlice(values...)
+	s.invalidate()
+	return s
+}
+
+// Reverse reverses the order of the elements.
+func (s *StringSlice) Reverse() IAdvancedSlice[string] {
+	s.advancedSlice.Reverse()
+	s.invalidate()
+	return s
+}
+
+// Shuffle randomly permutes the elements in place.
+func (s *StringSlice) Shuffle(r *rand.Rand) IAdvancedSlice[string] {
+	s.advancedSlice.Shuffle(r)
+	s.invalidate()
+	return s
+}
+
+// Remove removes the elements that satisfy f.
+func (s *StringSlice) Remove(f func(string, int) bool) IAdvancedSlice[string] {
+	s.advancedSlice.Remove(f)
+	s.invalidate()
+	return s
+}
+
+// RemoveAt removes the element at index.
+func (s *StringSlice) RemoveAt(index int) IAdvancedSlice[string] {
+	s.advancedSlice.RemoveAt(index)
+	s.invalidate()
+	return s
+}
+
+// RemoveAtErr removes the element at index, or returns an error as RemoveAtErr does.
+func (s *StringSlice) RemoveAtErr(index int) (IAdvancedSlice[string], error) {
+	_, err := s.advancedSlice.RemoveAtErr(index)
+	s.invalidate()
+	return s, err
+}
+
+// SliceErr keeps only the selected subset, or returns an error as SliceErr does.
+func (s *StringSlice) SliceErr(index ...int) (IAdvancedSlice[string], error) {
+	_, err := s.advancedSlice.SliceErr(index...)
+	s.invalidate()
+	return s, err
+}
+
+// Begin starts a transaction over the slice.
+func (s *StringSlice) Begin(validators ...Validator[string]) *Tx[string] {
+	return Begin[string](s, validators...)
+}
+
+// replace swaps in new contents.
+func (s *StringSlice) replace(values []string) {
+	s.data = values
+	s.invalidate()
+}
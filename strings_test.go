@@ -0,0 +1,153 @@
+package slice_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestStringFunctions(t *testing.T) {
+	s := []string{"Go", "rust", "GO", "Zig", "go"}
+	if !slice.ContainsFold(s, "RUST") || slice.ContainsFold(s, "c") {
+		t.Error("ContainsFold() gave the wrong answer")
+	}
+	if got := slice.IndexOfFold(s, "zig"); got != 3 {
+		t.Errorf("IndexOfFold() = %d, want 3", got)
+	}
+	if got, want := slice.UniqueFold(s), []string{"Go", "rust", "Zig"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("UniqueFold() = %v, want %v", got, want)
+	}
+	if got, want := slice.SortFold([]string{"b", "B", "a", "C", "A"}), []string{"A", "a", "B", "b", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortFold() = %v, want %v", got, want)
+	}
+	if got, want := slice.FilterEmpty(slice.TrimAll([]string{" a ", "\t", "", "b\n"})), []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterEmpty(TrimAll()) = %v, want %v", got, want)
+	}
+}
+
+func TestFoldNonASCII(t *testing.T) {
+	tests := []struct {
+		name string
+		s    []string
+		want []string
+	}{
+		{"sharp s", []string{"ß", "ẞ", "ss"}, []string{"ß", "ss"}},
+		{"kelvin sign", []string{"\u212A", "k", "K"}, []string{"\u212A"}},
+		{"greek sigma", []string{"σ", "ς", "Σ"}, []string{"σ"}},
+		{"dotted capital i", []string{"İ", "i"}, []string{"İ", "i"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.UniqueFold(append([]string(nil), tt.s...))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UniqueFold(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+			for _, v := range tt.s {
+				if i := slice.IndexOfFold(got, v); i < 0 || !strings.EqualFold(got[i], v) {
+					t.Errorf("UniqueFold(%q) kept no string IndexOfFold matches to %q", tt.s, v)
+				}
+			}
+		})
+	}
+	if got, want := slice.SortFold([]string{"ς", "b", "Σ", "a", "σ"}), []string{"a", "b", "Σ", "ς", "σ"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortFold() = %q, want %q", got, want)
+	}
+}
+
+func TestJoinVariants(t *testing.T) {
+	s := []string{"a", "b,c", `say "hi"`, `back\slash`}
+	if got, want := slice.JoinQuoted(s, ", "), `"a", "b,c", "say \"hi\"", "back\\slash"`; got != want {
+		t.Errorf("JoinQuoted() = %s, want %s", got, want)
+	}
+	if got, want := slice.JoinCSV(s), `a,"b,c","say ""hi""",back\slash`; got != want {
+		t.Errorf("JoinCSV() = %s, want %s", got, want)
+	}
+	if got, want := slice.JoinEscaped(s, ","), `a,b\,c,say "hi",back\\slash`; got != want {
+		t.Errorf("JoinEscaped() = %s, want %s", got, want)
+	}
+
+	tests := []struct {
+		name string
+		in   []string
+		sep  string
+	}{
+		{"single", []string{"x"}, ","},
+		{"empty parts", []string{"", "", ""}, ","},
+		{"separator inside", []string{"a,b", ",", "c,"}, ","},
+		{"backslashes", []string{`\`, `\,`, `a\`}, ","},
+		{"multi-byte separator", []string{"a::b", "::", "ü"}, "::"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			joined := slice.JoinEscaped(tt.in, tt.sep)
+			if got := slice.SplitEscaped(joined, tt.sep); !reflect.DeepEqual(got, tt.in) {
+				t.Errorf("SplitEscaped(%q) = %q, want %q", joined, got, tt.in)
+			}
+		})
+	}
+}
+
+func TestStringSlicePrefixIndex(t *testing.T) {
+	for _, indexed := range []bool{false, true} {
+		s := slice.NewStringSlice("apple", "banana", "apricot", "app")
+		if indexed {
+			s.IndexPrefixes()
+		}
+		if got, want := s.WithPrefix("ap"), []string{"apple", "apricot", "app"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("indexed=%v: WithPrefix(ap) = %v, want %v", indexed, got, want)
+		}
+		s.Push("apex", "cherry")
+		if got, want := s.WithPrefix("ap"), []string{"apple", "apricot", "app", "apex"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("indexed=%v: WithPrefix(ap) after Push = %v, want %v", indexed, got, want)
+		}
+		s.RemoveAt(0)
+		s.Unshift("apt")
+		if got, want := s.WithPrefix("ap"), []string{"apt", "apricot", "app", "apex"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("indexed=%v: WithPrefix(ap) after RemoveAt and Unshift = %v, want %v", indexed, got, want)
+		}
+		if !s.HasPrefix("ch") || s.HasPrefix("apples") || !s.HasPrefix("") {
+			t.Errorf("indexed=%v: HasPrefix() gave the wrong answer", indexed)
+		}
+		if got := s.WithPrefix("z"); got != nil {
+			t.Errorf("indexed=%v: WithPrefix(z) = %v, want nil", indexed, got)
+		}
+		empty := slice.NewStringSlice()
+		if indexed {
+			empty.IndexPrefixes()
+		}
+		if empty.HasPrefix("") {
+			t.Errorf("indexed=%v: HasPrefix(\"\") on an empty slice = true", indexed)
+		}
+	}
+}
+
+func TestStringSliceAdvancedSlice(t *testing.T) {
+	s := slice.NewStringSlice(" Go ", "go", "", "Rust", "  ")
+	s.TrimAll().FilterEmpty()
+	if got, want := s.Values(), []string{"Go", "go", "Rust"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("TrimAll().FilterEmpty() = %v, want %v", got, want)
+	}
+	if !s.ContainsFold("GO") || s.Contains("GO") || s.IndexOfFold("rust") != 2 {
+		t.Error("case-insensitive lookups gave the wrong answer")
+	}
+	s.UniqueFold().SortFold().Push("c")
+	s.SortFold()
+	if got, want := s.Values(), []string{"c", "Go", "Rust"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("UniqueFold().Push().SortFold() = %v, want %v", got, want)
+	}
+	if got, want := s.JoinQuoted(" "), `"c" "Go" "Rust"`; got != want {
+		t.Errorf("JoinQuoted() = %s, want %s", got, want)
+	}
+	var _ slice.IAdvancedSlice[string] = s.Reverse()
+	tx := s.Begin()
+	tx.Push("Zig")
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	s.IndexPrefixes()
+	if got, want := s.WithPrefix("Z"), []string{"Zig"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WithPrefix() after Commit = %v, want %v", got, want)
+	}
+}
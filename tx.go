@@ -0,0 +1,323 @@
+package slice
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+var _ IAdvancedSlice[any] = (*Tx[any])(nil)
+
+// Validator checks an invariant over the elements of a transaction before it is committed.
+type Validator[T any] func(values []T) error
+
+// replacer is implemented by the slices in this package that can swap in new contents at once,
+// keeping their own bookkeeping such as indexes, limits, events and history.
+type replacer[T any] interface {
+	replace(values []T)
+}
+
+// configured is implemented by the slices in this package, so that a transaction over one of them
+// sorts, deduplicates and resolves indices the same way.
+type configured[T any] interface {
+	config() (less func(a, b T) bool, key func(T) string, bounds BoundsPolicy)
+}
+
+// Tx is a transaction over an advanced slice. It offers the full IAdvancedSlice API on a private
+// copy of the elements; the changes reach the original slice only when Commit succeeds.
+// Commit replaces the contents of the original wholesale, so changes made to it directly during
+// the transaction are overwritten.
+// The transaction starts with the comparator, key function and bounds policy of the original.
+// Once it has been committed or rolled back, mutations leave it unchanged and record ErrTxDone,
+// which Err returns; the Err variants return ErrTxDone directly.
+type Tx[T any] struct {
+	*advancedSlice[T]
+	target     IAdvancedSlice[T]
+	validators []Validator[T]
+	done       bool
+}
+
+// Begin starts a transaction over an advanced slice.
+//
+// Parameters:
+//   - s: The slice the transaction commits to.
+//   - validators: Invariants checked against the transaction's elements by Commit.
+//
+// Returns:
+//
+//   - *Tx[T]: The transaction.
+//
+// Example:
+//
+//	tx := Begin(orders, func(v []Order) error {
+//		if len(v) > 100 {
+//			return errors.New("too many orders")
+//		}
+//		return nil
+//	})
+//	tx.Remove(isCancelled).Push(newOrders...)
+//	if err := tx.Commit(); err != nil {
+//		tx.Rollback()
+//	}
+func Begin[T any](s IAdvancedSlice[T], validators ...Validator[T]) *Tx[T] {
+	staged := &advancedSlice[T]{data: slices.Clone(s.Values())}
+	if c, ok := s.(configured[T]); ok {
+		staged.less, staged.key, staged.bounds = c.config()
+	}
+	return &Tx[T]{
+		advancedSlice: staged,
+		target:        s,
+		validators:    validators,
+	}
+}
+
+// closed reports whether the transaction has ended, recording ErrTxDone if it has.
+func (t *Tx[T]) closed() bool {
+	if t.done {
+		t.err = ErrTxDone
+	}
+	return t.done
+}
+
+// Commit runs the validators and, if they all pass, replaces the contents of the original slice
+// with the transaction's elements. After a validation failure the transaction stays open, so it
+// can be corrected and committed again, or rolled back.
+//
+// Returns:
+//
+//   - error: ErrTxDone if the transaction has already ended, otherwise the first validator error.
+func (t *Tx[T]) Commit() error {
+	if t.done {
+		return ErrTxDone
+	}
+	for _, validate := range t.validators {
+		if err := validate(t.data); err != nil {
+			return err
+		}
+	}
+	t.done = true
+	values := slices.Clone(t.data)
+	if r, ok := t.target.(replacer[T]); ok {
+		r.replace(values)
+		return nil
+	}
+	t.target.Remove(func(T, int) bool { return true })
+	t.target.Push(values...)
+	return nil
+}
+
+// Rollback discards the transaction, leaving the original slice untouched.
+//
+// Returns:
+//
+//   - error: ErrTxDone if the transaction has already ended.
+func (t *Tx[T]) Rollback() error {
+	if t.done {
+		return ErrTxDone
+	}
+	t.done = true
+	return nil
+}
+
+// Begin starts a transaction nested in this one; committing it updates this transaction only.
+func (t *Tx[T]) Begin(validators ...Validator[T]) *Tx[T] {
+	return Begin[T](t, validators...)
+}
+
+// Map replaces each element with the result of f.
+func (t *Tx[T]) Map(f func(T, int) T) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.Map(f)
+	}
+	return t
+}
+
+// Unique keeps the first occurrence of each key returned by f.
+func (t *Tx[T]) Unique(f func(T) string) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.Unique(f)
+	}
+	return t
+}
+
+// Concat appends the elements of the given slices.
+func (t *Tx[T]) Concat(ss ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.Concat(ss...)
+	}
+	return t
+}
+
+// CopyWithIn keeps only the elements at the given indices.
+func (t *Tx[T]) CopyWithIn(indexes ...int) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.CopyWithIn(indexes...)
+	}
+	return t
+}
+
+// Slice keeps only the selected subset.
+func (t *Tx[T]) Slice(index ...int) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.Slice(index...)
+	}
+	return t
+}
+
+// Fill sets the selected elements to value.
+func (t *Tx[T]) Fill(value T, index ...int) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.Fill(value, index...)
+	}
+	return t
+}
+
+// Sort sorts the elements using f.
+func (t *Tx[T]) Sort(f func(T, T) bool) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.Sort(f)
+	}
+	return t
+}
+
+// Push appends the values.
+func (t *Tx[T]) Push(values ...T) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.Push(values...)
+	}
+	return t
+}
+
+// PushSlice appends the elements of the given slices.
+func (t *Tx[T]) PushSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.PushSlice(values...)
+	}
+	return t
+}
+
+// Unshift prepends the values.
+func (t *Tx[T]) Unshift(values ...T) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.Unshift(values...)
+	}
+	return t
+}
+
+// UnshiftSlice prepends the elements of each given slice in turn.
+func (t *Tx[T]) UnshiftSlice(values ...IAdvancedSlice[T]) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.UnshiftSlice(values...)
+	}
+	return t
+}
+
+// Reverse reverses the order of the elements.
+func (t *Tx[T]) Reverse() IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.Reverse()
+	}
+	return t
+}
+
+// Shuffle randomly permutes the elements in place.
+func (t *Tx[T]) Shuffle(r *rand.Rand) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.Shuffle(r)
+	}
+	return t
+}
+
+// Remove removes the elements that satisfy f.
+func (t *Tx[T]) Remove(f func(T, int) bool) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.Remove(f)
+	}
+	return t
+}
+
+// RemoveAt removes the element at index.
+func (t *Tx[T]) RemoveAt(index int) IAdvancedSlice[T] {
+	if !t.closed() {
+		t.advancedSlice.RemoveAt(index)
+	}
+	return t
+}
+
+// RemoveAtErr removes the element at index, or returns an error as RemoveAtErr does.
+func (t *Tx[T]) RemoveAtErr(index int) (IAdvancedSlice[T], error) {
+	if t.done {
+		return t, ErrTxDone
+	}
+	_, err := t.advancedSlice.RemoveAtErr(index)
+	return t, err
+}
+
+// SliceErr keeps only the selected subset, or returns an error as SliceErr does.
+func (t *Tx[T]) SliceErr(index ...int) (IAdvancedSlice[T], error) {
+	if t.done {
+		return t, ErrTxDone
+	}
+	_, err := t.advancedSlice.SliceErr(index...)
+	return t, err
+}
+
+// Pop removes and returns the last element.
+func (t *Tx[T]) Pop() T {
+	if t.closed() {
+		var zero T
+		return zero
+	}
+	return t.advancedSlice.Pop()
+}
+
+// PopIs removes and returns the last element, and whether there was one.
+func (t *Tx[T]) PopIs() (T, bool) {
+	if t.closed() {
+		var zero T
+		return zero, false
+	}
+	return t.advancedSlice.PopIs()
+}
+
+// PopErr removes and returns the last element, or returns ErrEmpty, or ErrTxDone once the transaction has ended.
+func (t *Tx[T]) PopErr() (T, error) {
+	if t.done {
+		var zero T
+		return zero, ErrTxDone
+	}
+	return t.advancedSlice.PopErr()
+}
+
+// Shift removes and returns the first element.
+func (t *Tx[T]) Shift() T {
+	if t.closed() {
+		var zero T
+		return zero
+	}
+	return t.advancedSlice.Shift()
+}
+
+// ShiftIs removes and returns the first element, and whether there was one.
+func (t *Tx[T]) ShiftIs() (T, bool) {
+	if t.closed() {
+		var zero T
+		return zero, false
+	}
+	return t.advancedSlice.ShiftIs()
+}
+
+// ShiftErr removes and returns the first element, or returns ErrEmpty, or ErrTxDone once the transaction has ended.
+func (t *Tx[T]) ShiftErr() (T, error) {
+	if t.done {
+		var zero T
+		return zero, ErrTxDone
+	}
+	return t.advancedSlice.ShiftErr()
+}
+
+// replace swaps in new contents when a nested transaction commits.
+func (t *Tx[T]) replace(values []T) {
+	if !t.closed() {
+		t.data = values
+	}
+}
@@ -0,0 +1,207 @@
+package slice_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/aide-cloud/slice"
+)
+
+func TestTxCommitRollback(t *testing.T) {
+	s := slice.NewAdvancedSlice(1, 2, 3, 4)
+	tx := s.Begin()
+	tx.Remove(func(v, _ int) bool { return v%2 == 0 }).Push(5).Fill(0, 0, 1)
+	if got := s.Values(); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("original changed before Commit: %v", got)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	if got := s.Values(); !reflect.DeepEqual(got, []int{0, 3, 5}) {
+		t.Errorf("after Commit = %v, want [0 3 5]", got)
+	}
+	if err := tx.Commit(); !errors.Is(err, slice.ErrTxDone) {
+		t.Errorf("second Commit() error = %v, want ErrTxDone", err)
+	}
+
+	tx = s.Begin()
+	tx.Push(6)
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback() error = %v", err)
+	}
+	if got := s.Values(); !reflect.DeepEqual(got, []int{0, 3, 5}) {
+		t.Errorf("after Rollback = %v, want [0 3 5]", got)
+	}
+	if err := tx.Rollback(); !errors.Is(err, slice.ErrTxDone) {
+		t.Errorf("second Rollback() error = %v, want ErrTxDone", err)
+	}
+}
+
+func TestTxValidators(t *testing.T) {
+	errTooLong := errors.New("too long")
+	maxLen := func(n int) slice.Validator[int] {
+		return func(v []int) error {
+			if len(v) > n {
+				return errTooLong
+			}
+			return nil
+		}
+	}
+	s := slice.NewAdvancedSlice(1, 2)
+	tx := s.Begin(maxLen(3))
+	tx.Push(3, 4)
+	if err := tx.Commit(); !errors.Is(err, errTooLong) {
+		t.Fatalf("Commit() error = %v, want %v", err, errTooLong)
+	}
+	if got := s.Values(); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("original changed after failed Commit: %v", got)
+	}
+	tx.Pop()
+	if err := tx.Commit(); err != nil {
+		t.Errorf("Commit() after fixing error = %v", err)
+	}
+	if got := s.Values(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("after Commit = %v, want [1 2 3]", got)
+	}
+}
+
+func TestTxTargets(t *testing.T) {
+	t.Run("indexed", func(t *testing.T) {
+		s := slice.NewIndexedSlice(func(v int) int { return v }, 1, 2, 3)
+		tx := s.Begin()
+		tx.RemoveAt(0).Push(4)
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		if !s.HasKey(4) || s.HasKey(1) || s.IndexOfKey(2) != 0 {
+			t.Errorf("index not rebuilt after Commit: %v", s.Values())
+		}
+	})
+	t.Run("bounded", func(t *testing.T) {
+		s := slice.NewBoundedSlice(3, slice.OverflowReject, 1, 2)
+		tx := s.Begin()
+		tx.Push(3, 4, 5)
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		if got := s.Values(); !reflect.DeepEqual(got, []int{3, 4, 5}) {
+			t.Errorf("Values() = %v, want the last 3 elements", got)
+		}
+	})
+	t.Run("history", func(t *testing.T) {
+		s := slice.NewHistorySlice(0, 1, 2)
+		tx := s.Begin()
+		tx.Push(3).Reverse()
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		s.Undo()
+		if got := s.Values(); !reflect.DeepEqual(got, []int{1, 2}) {
+			t.Errorf("Undo() after Commit = %v, want [1 2]", got)
+		}
+	})
+	t.Run("observable", func(t *testing.T) {
+		s := slice.NewObservableSlice(1)
+		var kinds []slice.EventKind
+		s.Subscribe(func(e slice.Event[int]) { kinds = append(kinds, e.Kind) })
+		tx := s.Begin()
+		tx.Push(2)
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		if want := []slice.EventKind{slice.EventCleared, slice.EventInserted}; !reflect.DeepEqual(kinds, want) {
+			t.Errorf("events = %v, want %v", kinds, want)
+		}
+	})
+	t.Run("deque", func(t *testing.T) {
+		s := slice.NewDeque(1, 2)
+		tx := s.Begin()
+		tx.Unshift(0)
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		if got := s.Values(); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+			t.Errorf("Values() = %v, want [0 1 2]", got)
+		}
+	})
+	t.Run("nested", func(t *testing.T) {
+		s := slice.NewAdvancedSlice(1)
+		outer := s.Begin()
+		inner := outer.Begin()
+		inner.Push(2)
+		if err := inner.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		if got := s.Values(); !reflect.DeepEqual(got, []int{1}) {
+			t.Errorf("original changed by inner Commit: %v", got)
+		}
+		if err := outer.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		if got := s.Values(); !reflect.DeepEqual(got, []int{1, 2}) {
+			t.Errorf("after outer Commit = %v, want [1 2]", got)
+		}
+	})
+}
+
+func TestTxInheritsConfiguration(t *testing.T) {
+	s := slice.NewAdvancedSliceWith([]int{3, 1, 13, 2},
+		slice.WithComparator(func(a, b int) bool { return a < b }),
+		slice.WithKey(func(v int) string { return strconv.Itoa(v % 10) }),
+		slice.WithBoundsPolicy[int](slice.BoundsError),
+	)
+	tx := s.Begin()
+	tx.Sort(nil).Unique(nil)
+	if got, want := tx.Values(), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort(nil).Unique(nil) = %v, want %v", got, want)
+	}
+	tx.At(9)
+	if err := tx.Err(); !errors.Is(err, slice.ErrIndexOutOfRange) {
+		t.Errorf("Err() after At(9) = %v, want ErrIndexOutOfRange", err)
+	}
+
+	ordered := slice.NewOrderedSlice(3, 1, 2).Begin()
+	if got, want := ordered.Sort(nil).Values(), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort(nil) over an ordered slice = %v, want %v", got, want)
+	}
+}
+
+func TestTxMutateAfterDone(t *testing.T) {
+	s := slice.NewAdvancedSlice(1, 2, 3)
+	tx := s.Begin()
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	tx.Push(4).RemoveAt(0).Reverse()
+	tx.Pop()
+	if got, want := tx.Values(), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() after mutating a committed transaction = %v, want %v", got, want)
+	}
+	if err := tx.Err(); !errors.Is(err, slice.ErrTxDone) {
+		t.Errorf("Err() = %v, want ErrTxDone", err)
+	}
+	if _, err := tx.PopErr(); !errors.Is(err, slice.ErrTxDone) {
+		t.Errorf("PopErr() error = %v, want ErrTxDone", err)
+	}
+	if _, err := tx.RemoveAtErr(0); !errors.Is(err, slice.ErrTxDone) {
+		t.Errorf("RemoveAtErr(0) error = %v, want ErrTxDone", err)
+	}
+
+	tx = s.Begin()
+	nested := tx.Begin()
+	nested.Push(4)
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if err := nested.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Err(); !errors.Is(err, slice.ErrTxDone) {
+		t.Errorf("Err() after a nested commit into a rolled back transaction = %v, want ErrTxDone", err)
+	}
+	if got, want := s.Values(), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("original = %v, want %v", got, want)
+	}
+}